@@ -0,0 +1,301 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig"
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+type (
+	Instr struct {
+		Op       string
+		Sym      plural.Symbol
+		Mod, Arg int
+	}
+
+	CondCode struct {
+		Cond       string
+		Start, End int
+	}
+
+	TableRule struct {
+		Form       string
+		Start, End int
+	}
+
+	RuleSet struct {
+		Langs    []string
+		Cardinal []TableRule
+		Ordinal  []TableRule
+	}
+
+	TableTag struct {
+		Tag   string
+		Index int
+	}
+)
+
+var compareOps = map[token.Token]string{
+	token.EQL: "opEq",
+	token.NEQ: "opNe",
+	token.LSS: "opLt",
+	token.GTR: "opGt",
+	token.LEQ: "opLe",
+	token.GEQ: "opGe",
+}
+
+// compileCond translates a generated Go condition such as
+// "v == 0 && (i10 < 2 || i10 > 4)" into the compact bytecode evaluated by
+// plural/compact.go. Jump targets are relative to the first instruction.
+func compileCond(cond string) ([]Instr, error) {
+	expr, err := parser.ParseExpr(cond)
+	if err != nil {
+		return nil, err
+	}
+
+	var code []Instr
+	var compile func(e ast.Expr) error
+	compile = func(e ast.Expr) error {
+		switch e := e.(type) {
+		case *ast.ParenExpr:
+			return compile(e.X)
+
+		case *ast.Ident:
+			sym, mod, err := condOperand(e)
+			if err != nil {
+				return err
+			}
+			code = append(code, Instr{"opNe", sym, mod, 0})
+			return nil
+
+		case *ast.BinaryExpr:
+			if e.Op == token.LAND || e.Op == token.LOR {
+				if err := compile(e.X); err != nil {
+					return err
+				}
+				jump := len(code)
+				if e.Op == token.LAND {
+					code = append(code, Instr{Op: "opAnd"})
+				} else {
+					code = append(code, Instr{Op: "opOr"})
+				}
+				if err := compile(e.Y); err != nil {
+					return err
+				}
+				code[jump].Arg = len(code)
+				return nil
+			}
+
+			op, ok := compareOps[e.Op]
+			if !ok {
+				return fmt.Errorf("unsupported operator %s in `%s`", e.Op, cond)
+			}
+			sym, mod, err := condOperand(e.X)
+			if err != nil {
+				return err
+			}
+			lit, ok := e.Y.(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				return fmt.Errorf("integer expected in `%s`", cond)
+			}
+			arg, err := strconv.Atoi(lit.Value)
+			if err != nil {
+				return err
+			}
+			code = append(code, Instr{op, sym, mod, arg})
+			return nil
+		}
+		return fmt.Errorf("unsupported expression in `%s`", cond)
+	}
+
+	if err := compile(expr); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// condOperand splits a variable such as "n" or "i100" into its symbol and
+// modulus.
+func condOperand(e ast.Expr) (plural.Symbol, int, error) {
+	ident, ok := e.(*ast.Ident)
+	if !ok || "" == ident.Name {
+		return 0, 0, fmt.Errorf("operand expected, got %T", e)
+	}
+	sym, err := toSymbol(ident.Name[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	mod := 0
+	if len(ident.Name) > 1 {
+		mod, err = strconv.Atoi(ident.Name[1:])
+		if err != nil {
+			return 0, 0, fmt.Errorf("operand expected, got `%s`", ident.Name)
+		}
+	}
+	return sym, mod, nil
+}
+
+type tablesTplData struct {
+	Headers   string
+	Timestamp string
+	Code      []Instr
+	Conds     []CondCode
+	RuleSets  []*RuleSet
+	Tags      []TableTag
+}
+
+type tablesBuilder struct {
+	tablesTplData
+	conds map[string]CondCode
+	tags  map[string]int
+}
+
+func (tb *tablesBuilder) rules(cases plural.Cases) ([]TableRule, error) {
+	var rules []TableRule
+	for _, c := range cases {
+		cc, ok := tb.conds[c.Cond]
+		if !ok {
+			code, err := compileCond(c.Cond)
+			if err != nil {
+				return nil, err
+			}
+			cc = CondCode{c.Cond, len(tb.Code), len(tb.Code) + len(code)}
+			tb.Code = append(tb.Code, code...)
+			tb.Conds = append(tb.Conds, cc)
+			tb.conds[c.Cond] = cc
+		}
+		rules = append(rules, TableRule{c.Form, cc.Start, cc.End})
+	}
+	return rules, nil
+}
+
+func (tb *tablesBuilder) addTags(langs []string, index int) error {
+	for _, lang := range langs {
+		tag := language.MustParse(lang).String()
+		if exist, ok := tb.tags[tag]; ok && exist != index {
+			return fmt.Errorf("`%s` resolves to different rule sets", lang)
+		}
+		tb.tags[tag] = index
+	}
+	return nil
+}
+
+// buildTables compiles the rules of every culture once, all the locales of a
+// culture sharing the same rule set. Others get a single empty rule set.
+func buildTables(headers string, cultures []*plural.Culture, others []string) (*tablesTplData, error) {
+	tb := &tablesBuilder{
+		tablesTplData: tablesTplData{Headers: headers, Timestamp: time.Now().Format(time.RFC3339)},
+		conds:         make(map[string]CondCode),
+		tags:          make(map[string]int),
+	}
+
+	for _, c := range cultures {
+		rs := &RuleSet{Langs: c.Langs}
+
+		var err error
+		if rs.Cardinal, err = tb.rules(c.Cardinal); err != nil {
+			return nil, err
+		}
+		if rs.Ordinal, err = tb.rules(c.Ordinal); err != nil {
+			return nil, err
+		}
+		if err = tb.addTags(c.Langs, len(tb.RuleSets)); err != nil {
+			return nil, err
+		}
+		tb.RuleSets = append(tb.RuleSets, rs)
+	}
+
+	if len(others) > 0 {
+		if err := tb.addTags(others, len(tb.RuleSets)); err != nil {
+			return nil, err
+		}
+		tb.RuleSets = append(tb.RuleSets, &RuleSet{Langs: others})
+	}
+
+	for tag, index := range tb.tags {
+		tb.Tags = append(tb.Tags, TableTag{tag, index})
+	}
+	sort.Slice(tb.Tags, func(i, j int) bool { return tb.Tags[i].Tag < tb.Tags[j].Tag })
+	return &tb.tablesTplData, nil
+}
+
+const tablesTplStr = `// Generated by https://github.com/gotnospirit/makeplural
+// at {{ .Timestamp }}
+{{ .Headers }}
+package plural
+
+var ruleCode = [...]instr{
+	{{- range $cond := .Conds }}
+	// {{ $cond.Cond }}
+	{{- range slice $.Code $cond.Start $cond.End }}
+	{ {{ .Op }}, {{ .Sym.String }}, {{ .Mod }}, {{ .Arg }} },
+	{{- end }}
+	{{- end }}
+}
+
+var ruleSets = [...]ruleSet{
+	{{- range .RuleSets }}
+	// {{ join ", " .Langs }}
+	{
+		{{- if .Cardinal }}
+		cardinal: {{ template "rules" .Cardinal }},
+		{{- end }}
+		{{- if .Ordinal }}
+		ordinal: {{ template "rules" .Ordinal }},
+		{{- end }}
+	},
+	{{- end }}
+}
+
+var ruleSetTags = [...]string{
+	{{- range .Tags }}
+	"{{ .Tag }}",
+	{{- end }}
+}
+
+var ruleSetIndex = [...]uint16{
+	{{- range .Tags }}
+	{{ .Index }}, // {{ .Tag }}
+	{{- end }}
+}
+`
+
+const rulesTplStr = `[]rule{
+	{{- range . }}
+	{ {{ .Form | title }}, {{ .Start }}, {{ .End }} },
+	{{- end }}
+}`
+
+var tablesTpl = template.Must(template.New("tables").
+	Funcs(sprig.TxtFuncMap()).
+	Parse(tablesTplStr))
+
+func init() {
+	template.Must(tablesTpl.New("rules").Parse(rulesTplStr))
+}
+
+func createTables(dest_filepath string, data *tablesTplData) error {
+	file, err := createSourceFile(dest_filepath)
+	if nil != err {
+		return err
+	}
+	defer file.Close()
+
+	err = tablesTpl.Execute(file, data)
+	if err != nil {
+		return err
+	}
+
+	return file.Save()
+}
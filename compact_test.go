@@ -0,0 +1,162 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// testOperands are the operands of a decimal string, as computed by finvtw.
+type testOperands struct {
+	n       float64
+	i, f, t int64
+	v, w    int
+}
+
+func newTestOperands(value string) (o testOperands, err error) {
+	if o.n, err = strconv.ParseFloat(value, 64); nil != err {
+		return
+	}
+	o.n = math.Abs(o.n)
+	integer, fraction := strings.TrimPrefix(value, "-"), ""
+	if dot := strings.IndexByte(integer, '.'); -1 != dot {
+		integer, fraction = integer[:dot], integer[dot+1:]
+	}
+	if o.i, err = strconv.ParseInt(integer, 10, 64); nil != err {
+		return
+	}
+	trimmed := strings.TrimRight(fraction, "0")
+	o.v, o.w = len(fraction), len(trimmed)
+	if "" != fraction {
+		o.f, _ = strconv.ParseInt(fraction, 10, 64)
+	}
+	if "" != trimmed {
+		o.t, _ = strconv.ParseInt(trimmed, 10, 64)
+	}
+	return
+}
+
+// evalInstr evaluates the code of compileCond as plural/compact.go does.
+func evalInstr(code []Instr, o testOperands) bool {
+	var acc bool
+	for pc := 0; pc < len(code); pc++ {
+		in := code[pc]
+		switch in.Op {
+		case "opAnd":
+			if !acc {
+				pc = in.Arg - 1
+			}
+			continue
+		case "opOr":
+			if acc {
+				pc = in.Arg - 1
+			}
+			continue
+		}
+
+		var x float64
+		switch in.Sym {
+		case plural.N:
+			x = o.n
+			if 0 != in.Mod {
+				x = math.Mod(o.n, float64(in.Mod))
+			}
+		case plural.P:
+			x = 0
+			if 0 == o.w {
+				x = 1
+			}
+		default:
+			x = float64(map[plural.Symbol]int64{
+				plural.I: o.i, plural.F: o.f, plural.T: o.t,
+				plural.V: int64(o.v), plural.W: int64(o.w),
+			}[in.Sym])
+			if 0 != in.Mod {
+				x = float64(int64(x) % int64(in.Mod))
+			}
+		}
+
+		arg := float64(in.Arg)
+		acc = map[string]bool{
+			"opEq": x == arg, "opNe": x != arg,
+			"opLt": x < arg, "opGt": x > arg,
+			"opLe": x <= arg, "opGe": x >= arg,
+		}[in.Op]
+	}
+	return acc
+}
+
+// TestCompileCond compares the compiled conditions of every generated
+// culture with its closure, on its CLDR samples and on small numbers.
+func TestCompileCond(t *testing.T) {
+	var values []string
+	for i := 0; i <= 200; i++ {
+		values = append(values, strconv.Itoa(i))
+		for _, fraction := range []string{".0", ".1", ".5", ".00", ".01", ".25"} {
+			values = append(values, strconv.Itoa(i)+fraction)
+		}
+	}
+
+	for _, culture := range plural.Info.Cultures {
+		fn, err := plural.GetFunc(language.MustParse(culture.Langs[0]))
+		if nil != err {
+			t.Fatalf("`%s` unexpected error: %s", culture.Langs[0], err.Error())
+		}
+
+		compile := func(cases plural.Cases) [][]Instr {
+			code := make([][]Instr, len(cases))
+			for i, c := range cases {
+				if code[i], err = compileCond(c.Cond); nil != err {
+					t.Fatalf("`%s` unexpected error: %s", c.Cond, err.Error())
+				}
+			}
+			return code
+		}
+		cardinal, ordinal := compile(culture.Cardinal), compile(culture.Ordinal)
+
+		samples := values
+		for _, tests := range [][]plural.UnitTest{culture.Tests.Cardinal, culture.Tests.Ordinal} {
+			for _, ut := range tests {
+				samples = append(append(samples, ut.Integers...), ut.Decimals...)
+			}
+		}
+		for _, value := range samples {
+			o, err := newTestOperands(value)
+			if nil != err {
+				t.Fatalf("`%s` unexpected error: %s", value, err.Error())
+			}
+			for _, kind := range []struct {
+				ordinal bool
+				cases   plural.Cases
+				code    [][]Instr
+			}{{false, culture.Cardinal, cardinal}, {true, culture.Ordinal, ordinal}} {
+				// ordinals are only defined for integers
+				result := "other"
+				if !kind.ordinal || 0 == o.w {
+					for i, code := range kind.code {
+						if evalInstr(code, o) {
+							result = kind.cases[i].Form
+							break
+						}
+					}
+				}
+				if expected := fn(value, kind.ordinal); expected != result {
+					t.Errorf("`%s` %s ordinal=%v expecting <%s> but got <%s>", culture.Langs[0], value, kind.ordinal, expected, result)
+				}
+			}
+		}
+	}
+}
+
+func TestCompileCondErrors(t *testing.T) {
+	for _, cond := range []string{"", "i ==", "x == 1", "i == 1.5", "i + 1 == 2", "i == j", "c10 == 1 &&"} {
+		if _, err := compileCond(cond); nil == err {
+			t.Errorf("`%s` expecting an error", cond)
+		}
+	}
+}
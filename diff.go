@@ -0,0 +1,372 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+	"github.com/louischan-oursky/gomakeplural/plural/verify"
+)
+
+// maxExamples bounds the numbers listed per locale and kind of rules.
+const maxExamples = 5
+
+// release is the plural rules of a CLDR release.
+type release struct {
+	source, version   string
+	plurals, ordinals map[string]map[string]string
+	// ranges are the plural ranges of a CLDR checkout, nil for cldr-json.
+	ranges map[string][]pluralRange
+}
+
+// loadRelease reads the rules of a directory holding plurals.json and
+// ordinals.json, or the LDML XML files of a CLDR checkout, or otherwise
+// fetches the given version of cldr-core, "36.0.0" or "master" for instance.
+func loadRelease(source string) (*release, error) {
+	if info, err := os.Stat(source); nil == err && info.IsDir() {
+		if _, err := os.Stat(filepath.Join(ldmlDir(source), "plurals.xml")); nil == err {
+			return loadLDMLRelease(source)
+		}
+	}
+	r := &release{source: source}
+
+	for _, kind := range []struct {
+		key  string
+		file string
+		data *map[string]map[string]string
+	}{
+		{"cardinal", "plurals.json", &r.plurals},
+		{"ordinal", "ordinals.json", &r.ordinals},
+	} {
+		var contents []byte
+		var err error
+		path := fmt.Sprintf("https://github.com/unicode-cldr/cldr-core/raw/%s/supplemental/%s", source, kind.file)
+		if info, e := os.Stat(source); nil == e && info.IsDir() {
+			path = filepath.Join(source, kind.file)
+			contents, err = ioutil.ReadFile(path)
+		} else {
+			contents, err = fetch(path)
+		}
+		if nil == err {
+			*kind.data, r.version, err = decodeCLDR(contents, kind.key)
+		}
+		if nil != err {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+	}
+	fixCLDR(r.plurals)
+	return r, nil
+}
+
+// loadLDMLRelease reads the rules and the plural ranges of a CLDR checkout.
+func loadLDMLRelease(dir string) (*release, error) {
+	r := &release{source: dir}
+
+	for _, kind := range []struct {
+		key  string
+		file string
+		data *map[string]map[string]string
+	}{
+		{"cardinal", "plurals.xml", &r.plurals},
+		{"ordinal", "ordinals.xml", &r.ordinals},
+	} {
+		document, err := readLDML(dir, kind.file)
+		if nil != err {
+			return nil, err
+		}
+		*kind.data, r.version = document.rules(kind.key)
+	}
+
+	document, err := readLDML(dir, "pluralRanges.xml")
+	if nil == err {
+		r.ranges = document.ranges()
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	fixCLDR(r.plurals)
+	return r, nil
+}
+
+// culture parses the rules of lang, nil when the release lacks them.
+func (r *release) culture(lang string) (*plural.Culture, error) {
+	plurals, ok := r.plurals[lang]
+	if !ok {
+		return nil, nil
+	}
+	data, err := newCulture(lang, r.ordinals[lang], plurals)
+	if nil != err {
+		return nil, fmt.Errorf("%s (CLDR %s): %w", r.source, r.version, err)
+	}
+	return &data, nil
+}
+
+// rules returns the CLDR rules of lang as the verify interpreter reads them.
+func (r *release) rules(lang string) (*verify.Rules, error) {
+	rules, err := verify.Compile(r.plurals[lang], r.ordinals[lang])
+	if nil != err {
+		return nil, fmt.Errorf("%s (CLDR %s): `%s` %s", r.source, r.version, lang, err)
+	}
+	return rules, nil
+}
+
+// example is a number whose category changed between two releases.
+type example struct {
+	Value   string `json:"value"`
+	Ordinal bool   `json:"ordinal"`
+	Old     string `json:"old"`
+	New     string `json:"new"`
+}
+
+// localeDiff is how the rules of a locale changed between two releases.
+type localeDiff struct {
+	Locale string `json:"locale"`
+	// Status is "added", "removed", "changed" or "invalid" when the
+	// generator fails to parse the rules of either release.
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+
+	OldCardinal []string `json:"oldCardinal,omitempty"`
+	NewCardinal []string `json:"newCardinal,omitempty"`
+	OldOrdinal  []string `json:"oldOrdinal,omitempty"`
+	NewOrdinal  []string `json:"newOrdinal,omitempty"`
+
+	// RulesChanged tells the conditions differ, even when no example
+	// shows it.
+	RulesChanged bool `json:"rulesChanged,omitempty"`
+	// RangesChanged tells the plural ranges differ, both releases being
+	// CLDR checkouts.
+	RangesChanged bool      `json:"rangesChanged,omitempty"`
+	Examples      []example `json:"examples,omitempty"`
+}
+
+// diffReleases returns the locales whose plural rules differ, sorted.
+func diffReleases(from, to *release) []localeDiff {
+	langs := make(map[string]bool, len(from.plurals))
+	for _, r := range []*release{from, to} {
+		for lang := range r.plurals {
+			langs[lang] = true
+		}
+	}
+	sorted := make([]string, 0, len(langs))
+	for lang := range langs {
+		sorted = append(sorted, lang)
+	}
+	sort.Strings(sorted)
+
+	var diffs []localeDiff
+	for _, lang := range sorted {
+		d := localeDiff{Locale: lang}
+		if err := d.compare(from, to); nil != err {
+			d.Status, d.Error = "invalid", err.Error()
+		} else if nil != from.ranges && nil != to.ranges && !reflect.DeepEqual(from.ranges[lang], to.ranges[lang]) {
+			d.RangesChanged = true
+			if "" == d.Status {
+				d.Status = "changed"
+			}
+		}
+		if "" != d.Status {
+			diffs = append(diffs, d)
+		}
+	}
+	return diffs
+}
+
+// compare sets how the rules of the locale changed between the releases,
+// leaving Status empty when they are the same.
+func (d *localeDiff) compare(from, to *release) error {
+	a, err := from.culture(d.Locale)
+	if nil != err {
+		return err
+	}
+	b, err := to.culture(d.Locale)
+	if nil != err {
+		return err
+	}
+
+	if nil != a {
+		d.OldCardinal, d.OldOrdinal = categories(a.Cardinal), categories(a.Ordinal)
+	}
+	if nil != b {
+		d.NewCardinal, d.NewOrdinal = categories(b.Cardinal), categories(b.Ordinal)
+	}
+
+	switch {
+	case nil == a:
+		d.Status = "added"
+	case nil == b:
+		d.Status = "removed"
+	default:
+		// ordinals made of "other" only parse to no rules, as do missing
+		// ordinal data
+		d.RulesChanged = !reflect.DeepEqual(a.Cardinal, b.Cardinal) || !reflect.DeepEqual(a.Ordinal, b.Ordinal) ||
+			!reflect.DeepEqual(conditions(from.ordinals[d.Locale]), conditions(to.ordinals[d.Locale]))
+		if !d.RulesChanged {
+			return nil
+		}
+		d.Status = "changed"
+
+		ra, err := from.rules(d.Locale)
+		if nil != err {
+			return err
+		}
+		rb, err := to.rules(d.Locale)
+		if nil != err {
+			return err
+		}
+		if d.Examples, err = diffExamples(ra, rb); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// conditions returns the CLDR rules without their samples, nil when there
+// are none.
+func conditions(rules map[string]string) map[string]string {
+	if nil == rules {
+		return nil
+	}
+	result := make(map[string]string, len(rules))
+	for key, rule := range rules {
+		if pos := strings.Index(rule, "@"); -1 != pos {
+			rule = rule[:pos]
+		}
+		result[key] = strings.TrimSpace(rule)
+	}
+	return result
+}
+
+// diffExamples evaluates the CLDR rules of both releases on small numbers and
+// on their samples, and returns the first numbers whose category changed.
+func diffExamples(ra, rb *verify.Rules) ([]example, error) {
+	var values []string
+	seen := make(map[string]bool)
+	add := func(value string) {
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	for i := 0; i <= 1000; i++ {
+		add(strconv.Itoa(i))
+	}
+	for i := 0; i <= 200; i++ {
+		for _, fraction := range []string{".0", ".1", ".5", ".00", ".01", ".25"} {
+			add(strconv.Itoa(i) + fraction)
+		}
+	}
+	for _, s := range append(ra.Samples(), rb.Samples()...) {
+		add(s.Value)
+	}
+
+	var examples []example
+	for _, ordinal := range []bool{false, true} {
+		n := 0
+		for _, value := range values {
+			if ordinal && strings.ContainsRune(value, '.') {
+				// ordinals only differ on integers
+				continue
+			}
+			x, err := ra.Eval(value, ordinal)
+			if nil != err {
+				return nil, err
+			}
+			y, err := rb.Eval(value, ordinal)
+			if nil != err {
+				return nil, err
+			}
+			if x != y {
+				examples = append(examples, example{value, ordinal, x, y})
+				if n++; maxExamples == n {
+					break
+				}
+			}
+		}
+	}
+	return examples, nil
+}
+
+func writeDiff(w io.Writer, format string, from, to *release, diffs []localeDiff) error {
+	if "json" == format {
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		return e.Encode(diffs)
+	}
+
+	join := func(s []string) string {
+		return strings.Join(s, ",")
+	}
+	fmt.Fprintf(w, "--- %s (CLDR %s)\n+++ %s (CLDR %s)\n", from.source, from.version, to.source, to.version)
+	for _, d := range diffs {
+		switch d.Status {
+		case "added":
+			fmt.Fprintf(w, "+ %s: cardinal %s, ordinal %s\n", d.Locale, join(d.NewCardinal), join(d.NewOrdinal))
+		case "removed":
+			fmt.Fprintf(w, "- %s: cardinal %s, ordinal %s\n", d.Locale, join(d.OldCardinal), join(d.OldOrdinal))
+		case "invalid":
+			fmt.Fprintf(w, "! %s: %s\n", d.Locale, d.Error)
+		default:
+			var changes []string
+			if !reflect.DeepEqual(d.OldCardinal, d.NewCardinal) {
+				changes = append(changes, fmt.Sprintf("cardinal %s => %s", join(d.OldCardinal), join(d.NewCardinal)))
+			}
+			if !reflect.DeepEqual(d.OldOrdinal, d.NewOrdinal) {
+				changes = append(changes, fmt.Sprintf("ordinal %s => %s", join(d.OldOrdinal), join(d.NewOrdinal)))
+			}
+			if 0 == len(changes) && d.RulesChanged {
+				changes = append(changes, "same categories, rules rewritten")
+			}
+			if d.RangesChanged {
+				changes = append(changes, "plural ranges changed")
+			}
+			fmt.Fprintf(w, "~ %s: %s\n", d.Locale, strings.Join(changes, ", "))
+			for _, e := range d.Examples {
+				kind := "cardinal"
+				if e.Ordinal {
+					kind = "ordinal"
+				}
+				fmt.Fprintf(w, "    %s %s: %s => %s\n", kind, e.Value, e.Old, e.New)
+			}
+		}
+	}
+	if 0 == len(diffs) {
+		fmt.Fprintln(w, "No plural rule changed")
+	}
+	return nil
+}
+
+// runDiff compares the plural rules of two CLDR releases, args being those
+// following the diff subcommand.
+func runDiff(w io.Writer, args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	format := fs.String("format", "text", "Output format: text or json")
+	if err := fs.Parse(args); nil != err {
+		return err
+	}
+	if "text" != *format && "json" != *format {
+		return fmt.Errorf("UnknownFormat: `%s`", *format)
+	}
+	if 2 != fs.NArg() {
+		return fmt.Errorf("Usage: diff [-format=text|json] OLD NEW, a directory, a CLDR checkout or a CLDR version each")
+	}
+
+	from, err := loadRelease(fs.Arg(0))
+	if nil != err {
+		return err
+	}
+	to, err := loadRelease(fs.Arg(1))
+	if nil != err {
+		return err
+	}
+
+	return writeDiff(w, *format, from, to, diffReleases(from, to))
+}
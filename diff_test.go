@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func loadTestRelease(t *testing.T, version string) *release {
+	r, err := loadRelease("testdata/diff/" + version)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if version != r.version {
+		t.Fatalf("Expecting CLDR <%s> but got <%s>", version, r.version)
+	}
+	return r
+}
+
+func TestDiffReleases(t *testing.T) {
+	diffs := diffReleases(loadTestRelease(t, "35"), loadTestRelease(t, "38"))
+
+	ordinalTwo := func(value string) example { return example{value, true, "other", "two"} }
+	expected := []localeDiff{
+		{
+			Locale:      "ast",
+			Status:      "added",
+			NewCardinal: []string{"one", "other"},
+			NewOrdinal:  []string{"other"},
+		},
+		{
+			Locale:       "en",
+			Status:       "changed",
+			OldCardinal:  []string{"one", "other"},
+			NewCardinal:  []string{"one", "other"},
+			OldOrdinal:   []string{"one", "other"},
+			NewOrdinal:   []string{"one", "two", "other"},
+			RulesChanged: true,
+			Examples:     []example{ordinalTwo("2"), ordinalTwo("22"), ordinalTwo("32"), ordinalTwo("42"), ordinalTwo("52")},
+		},
+		{
+			Locale:       "fr",
+			Status:       "changed",
+			OldCardinal:  []string{"one", "other"},
+			NewCardinal:  []string{"one", "many", "other"},
+			OldOrdinal:   []string{"other"},
+			NewOrdinal:   []string{"one", "other"},
+			RulesChanged: true,
+			// the samples of CLDR 38 are compact, "1e6"
+			Examples: []example{
+				{"1000000", false, "other", "many"},
+				{"1c6", false, "other", "many"},
+				{"2c6", false, "other", "many"},
+				{"3c6", false, "other", "many"},
+				{"4c6", false, "other", "many"},
+				{"1", true, "other", "one"},
+			},
+		},
+		{
+			Locale:      "xh",
+			Status:      "removed",
+			OldCardinal: []string{"one", "other"},
+			OldOrdinal:  []string{"other"},
+		},
+	}
+	if len(expected) != len(diffs) {
+		t.Fatalf("Expecting %d diffs but got %+v", len(expected), diffs)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(expected[i], diffs[i]) {
+			t.Errorf("`%s` expecting %+v but got %+v", expected[i].Locale, expected[i], diffs[i])
+		}
+	}
+}
+
+func TestDiffReleasesStatus(t *testing.T) {
+	en := map[string]string{"pluralRule-count-one": "i = 1 and v = 0 @integer 1", "pluralRule-count-other": ""}
+	ranges := []pluralRange{{"one", "other", "other"}}
+
+	tests := []struct {
+		name     string
+		from, to *release
+		// expected is the status of en, empty when unchanged
+		expected      string
+		rulesChanged  bool
+		rangesChanged bool
+		examples      int
+	}{
+		{
+			name:     "same rules",
+			from:     &release{plurals: map[string]map[string]string{"en": en}},
+			to:       &release{plurals: map[string]map[string]string{"en": en}},
+			expected: "",
+		},
+		{
+			name: "rewritten rules",
+			from: &release{plurals: map[string]map[string]string{"en": en}},
+			to: &release{plurals: map[string]map[string]string{"en": {
+				"pluralRule-count-one":   "n = 1 @integer 1",
+				"pluralRule-count-other": "",
+			}}},
+			expected:     "changed",
+			rulesChanged: true,
+			// 1.0 and 1.00 are now one
+			examples: 2,
+		},
+		{
+			name: "equivalent rules",
+			from: &release{plurals: map[string]map[string]string{"en": en}},
+			to: &release{plurals: map[string]map[string]string{"en": {
+				"pluralRule-count-one":   "v = 0 and i = 1 @integer 1",
+				"pluralRule-count-other": "",
+			}}},
+			expected:     "changed",
+			rulesChanged: true,
+		},
+		{
+			name:         "ordinal data",
+			from:         &release{plurals: map[string]map[string]string{"en": en}},
+			to:           &release{plurals: map[string]map[string]string{"en": en}, ordinals: map[string]map[string]string{"en": {"pluralRule-count-other": " @integer 0~15"}}},
+			expected:     "changed",
+			rulesChanged: true,
+		},
+		{
+			name:     "ordinal samples",
+			from:     &release{plurals: map[string]map[string]string{"en": en}, ordinals: map[string]map[string]string{"en": {"pluralRule-count-other": " @integer 0~15"}}},
+			to:       &release{plurals: map[string]map[string]string{"en": en}, ordinals: map[string]map[string]string{"en": {"pluralRule-count-other": " @integer 0~15, 100"}}},
+			expected: "",
+		},
+		{
+			name: "invalid rules",
+			from: &release{plurals: map[string]map[string]string{"en": en}},
+			to: &release{plurals: map[string]map[string]string{"en": {
+				"pluralRule-count-one":   "x = 1 @integer 1",
+				"pluralRule-count-other": "",
+			}}},
+			expected: "invalid",
+		},
+		{
+			name:          "plural ranges",
+			from:          &release{plurals: map[string]map[string]string{"en": en}, ranges: map[string][]pluralRange{}},
+			to:            &release{plurals: map[string]map[string]string{"en": en}, ranges: map[string][]pluralRange{"en": ranges}},
+			expected:      "changed",
+			rangesChanged: true,
+		},
+		{
+			name:     "plural ranges of cldr-json",
+			from:     &release{plurals: map[string]map[string]string{"en": en}},
+			to:       &release{plurals: map[string]map[string]string{"en": en}, ranges: map[string][]pluralRange{"en": ranges}},
+			expected: "",
+		},
+	}
+	for _, test := range tests {
+		diffs := diffReleases(test.from, test.to)
+		if "" == test.expected {
+			if 0 != len(diffs) {
+				t.Errorf("`%s` expecting no diff but got %+v", test.name, diffs)
+			}
+			continue
+		}
+		if 1 != len(diffs) {
+			t.Errorf("`%s` expecting a diff but got %+v", test.name, diffs)
+			continue
+		}
+		d := diffs[0]
+		if test.expected != d.Status || test.rulesChanged != d.RulesChanged || test.rangesChanged != d.RangesChanged || test.examples != len(d.Examples) {
+			t.Errorf("`%s` unexpected diff %+v", test.name, d)
+		}
+		if "invalid" == test.expected && !strings.Contains(d.Error, "UnknownOperand") {
+			t.Errorf("`%s` expecting UnknownOperand but got <%s>", test.name, d.Error)
+		}
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	from, to := loadTestRelease(t, "35"), loadTestRelease(t, "38")
+
+	var b bytes.Buffer
+	if err := writeDiff(&b, "text", from, to, diffReleases(from, to)); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	for _, line := range []string{
+		"--- testdata/diff/35 (CLDR 35)\n+++ testdata/diff/38 (CLDR 38)\n",
+		"+ ast: cardinal one,other, ordinal other\n",
+		"~ en: ordinal one,other => one,two,other\n    ordinal 2: other => two\n",
+		"~ fr: cardinal one,other => one,many,other, ordinal other => one,other\n    cardinal 1000000: other => many\n",
+		"- xh: cardinal one,other, ordinal other\n",
+	} {
+		if !strings.Contains(b.String(), line) {
+			t.Errorf("Expecting %q in %q", line, b.String())
+		}
+	}
+
+	b.Reset()
+	if err := writeDiff(&b, "text", from, from, diffReleases(from, from)); nil != err || !strings.HasSuffix(b.String(), "No plural rule changed\n") {
+		t.Errorf("Unexpected output %q (%v)", b.String(), err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+var (
+	// ErrNotDefined is a locale without cardinal rules.
+	ErrNotDefined = plural.ErrNotDefined
+	// ErrMissingOther is a locale whose rules lack the mandatory "other",
+	// also checked by plural.Bundle.Validate.
+	ErrMissingOther = plural.ErrMissingOther
+	// ErrUnknownOperand is an operand the generator does not implement.
+	ErrUnknownOperand = errors.New("UnknownOperand")
+	// ErrInvalidOperand is an operand or a modulo that does not parse.
+	ErrInvalidOperand = errors.New("InvalidOperand")
+)
+
+// RuleError is a CLDR rule the generator failed to parse.
+type RuleError struct {
+	Form    string
+	Ordinal bool
+	Rule    string
+	Err     error
+}
+
+func (e *RuleError) Error() string {
+	kind := "cardinal"
+	if e.Ordinal {
+		kind = "ordinal"
+	}
+	rule := e.Rule
+	if pos := strings.Index(rule, "@"); -1 != pos {
+		rule = rule[:pos]
+	}
+	return fmt.Sprintf("%s `%s` rule `%s`: %s", kind, e.Form, strings.TrimSpace(rule), e.Err)
+}
+
+func (e *RuleError) Unwrap() error { return e.Err }
+
+// Severity tells whether an Issue prevents the generation.
+type Severity int
+
+const (
+	// Warning is a locale left out, the others being generated unless
+	// -strict is set.
+	Warning Severity = iota
+	// Error is a rule the generator does not understand; nothing is
+	// written.
+	Error
+)
+
+func (s Severity) String() string {
+	if Error == s {
+		return "error"
+	}
+	return "warning"
+}
+
+// Issue is a problem met with the CLDR rules of a locale.
+type Issue struct {
+	Locale   string
+	Severity Severity
+	Err      error
+}
+
+func (i *Issue) Error() string {
+	return fmt.Sprintf("`%s` %s: %s", i.Locale, i.Severity, i.Err)
+}
+
+func (i *Issue) Unwrap() error { return i.Err }
+
+// Issues are the problems met while parsing the CLDR rules.
+type Issues []*Issue
+
+// Count returns the number of issues of severity s.
+func (issues Issues) Count(s Severity) int {
+	n := 0
+	for _, i := range issues {
+		if s == i.Severity {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed tells nothing must be written, strict turning warnings into
+// failures.
+func (issues Issues) Failed(strict bool) bool {
+	return 0 != issues.Count(Error) || strict && 0 != issues.Count(Warning)
+}
+
+// Summary logs the count of errors and warnings, then every issue.
+func (issues Issues) Summary() {
+	if 0 == len(issues) {
+		return
+	}
+	log.Printf("%d error(s), %d warning(s):", issues.Count(Error), issues.Count(Warning))
+	for _, i := range issues {
+		log.Println(" ✗", i)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestIssuesFailed(t *testing.T) {
+	warning := &Issue{"bad", Warning, ErrMissingOther}
+	failure := &Issue{"kw", Error, ErrUnknownOperand}
+
+	tests := []struct {
+		issues         Issues
+		strict         bool
+		expected       bool
+		errs, warnings int
+	}{
+		{nil, false, false, 0, 0},
+		{nil, true, false, 0, 0},
+		{Issues{warning}, false, false, 0, 1},
+		{Issues{warning}, true, true, 0, 1},
+		{Issues{failure}, false, true, 1, 0},
+		{Issues{failure}, true, true, 1, 0},
+		{Issues{warning, failure, warning}, false, true, 1, 2},
+	}
+	for _, test := range tests {
+		if result := test.issues.Failed(test.strict); test.expected != result {
+			t.Errorf("%v strict=%v expecting <%v> but got <%v>", test.issues, test.strict, test.expected, result)
+		}
+		if test.errs != test.issues.Count(Error) || test.warnings != test.issues.Count(Warning) {
+			t.Errorf("%v expecting %d error(s), %d warning(s)", test.issues, test.errs, test.warnings)
+		}
+	}
+}
+
+// testIssuesRules are CLDR rules of en, whose rules are fine, of bad lacking
+// "other" and of kw using an operand the generator does not implement.
+func testIssuesRules(unknownOperand bool) (map[string]map[string]string, map[string]map[string]string) {
+	plurals := map[string]map[string]string{
+		"en":  {"pluralRule-count-one": "i = 1 and v = 0 @integer 1", "pluralRule-count-other": " @integer 0, 2~16"},
+		"bad": {"pluralRule-count-one": "n = 1 @integer 1"},
+	}
+	ordinals := map[string]map[string]string{
+		"xh": {"pluralRule-count-one": "n = 1 @integer 1"},
+	}
+	plurals["xh"] = plurals["en"]
+	if unknownOperand {
+		plurals["kw"] = map[string]string{"pluralRule-count-one": "x = 1 @integer 1", "pluralRule-count-other": ""}
+	}
+	return plurals, ordinals
+}
+
+func TestParseCulturesIssues(t *testing.T) {
+	plurals, ordinals := testIssuesRules(true)
+	cultures, err := selectCultures(plurals)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	issues := parseCultures(cultures, plurals, ordinals).issues
+
+	expected := []struct {
+		locale   string
+		severity Severity
+		err      error
+	}{
+		{"bad", Warning, ErrMissingOther},
+		{"kw", Error, ErrUnknownOperand},
+		{"xh", Warning, ErrMissingOther},
+	}
+	if len(expected) != len(issues) {
+		t.Fatalf("Expecting %d issues but got %v", len(expected), issues)
+	}
+	for i, e := range expected {
+		issue := issues[i]
+		if e.locale != issue.Locale || e.severity != issue.Severity || !errors.Is(issue, e.err) {
+			t.Errorf("Expecting `%s` %s %v but got %v", e.locale, e.severity, e.err, issue)
+		}
+	}
+
+	var ruleErr *RuleError
+	if !errors.As(issues[1], &ruleErr) || "one" != ruleErr.Form || ruleErr.Ordinal {
+		t.Errorf("`kw` expecting a RuleError but got %v", issues[1])
+	}
+	if expected := "`kw` error: cardinal `one` rule `x = 1`: UnknownOperand: `x`"; expected != issues[1].Error() {
+		t.Errorf("Expecting <%s> but got <%s>", expected, issues[1].Error())
+	}
+}
+
+func TestExportStrict(t *testing.T) {
+	defer func(s bool) { *strict = s }(*strict)
+
+	tests := []struct {
+		unknownOperand, strict bool
+		// written tells the bundle is written despite the issues
+		written bool
+	}{
+		{false, false, true},
+		{false, true, false},
+		{true, false, false},
+		{true, true, false},
+	}
+	for _, test := range tests {
+		*strict = test.strict
+		plurals, ordinals := testIssuesRules(test.unknownOperand)
+
+		var b bytes.Buffer
+		issues, err := runExport(&b, plurals, ordinals)
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if test.written != (0 != b.Len()) || test.written == issues.Failed(test.strict) {
+			t.Errorf("unknownOperand=%v strict=%v expecting written=%v but got %q, %v",
+				test.unknownOperand, test.strict, test.written, b.String(), issues)
+		}
+	}
+}
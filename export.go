@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// newBundle returns the CLDR rules of the cultures the generator keeps, in
+// the JSON bundle plural.LoadBundle reads at runtime.
+func newBundle(cultures []string, parsed *parsedCultures, allPlurals, allOrdinals map[string]map[string]string) *plural.Bundle {
+	b := &plural.Bundle{
+		Cardinal: make(map[string]map[string]string, len(cultures)),
+		Ordinal:  make(map[string]map[string]string, len(cultures)),
+	}
+	for _, culture := range cultures {
+		if nil != parsed.skipped(culture) {
+			continue
+		}
+		b.Cardinal[culture] = allPlurals[culture]
+		if ordinals, ok := allOrdinals[culture]; ok {
+			b.Ordinal[culture] = ordinals
+		}
+	}
+	return b
+}
+
+// runExport writes the bundle of the selected cultures to w, unless the
+// issues met with the CLDR rules fail the generation.
+func runExport(w io.Writer, allPlurals, allOrdinals map[string]map[string]string) (Issues, error) {
+	cultures, err := selectCultures(allPlurals)
+	if nil != err {
+		return nil, err
+	}
+	parsed := parseCultures(cultures, allPlurals, allOrdinals)
+	if parsed.issues.Failed(*strict) {
+		return parsed.issues, nil
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return parsed.issues, enc.Encode(newBundle(cultures, parsed, allPlurals, allOrdinals))
+}
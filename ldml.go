@@ -0,0 +1,266 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ldmlSupplemental is the part of an LDML supplemental document the
+// generator reads: plurals.xml, ordinals.xml, pluralRanges.xml,
+// supplementalData.xml, likelySubtags.xml and supplementalMetadata.xml.
+type ldmlSupplemental struct {
+	XMLName xml.Name
+	Version struct {
+		Number string `xml:"number,attr"`
+	} `xml:"version"`
+
+	Plurals []struct {
+		Type  string `xml:"type,attr"`
+		Rules []struct {
+			Locales string `xml:"locales,attr"`
+			Rules   []struct {
+				Count string `xml:"count,attr"`
+				Rule  string `xml:",chardata"`
+			} `xml:"pluralRule"`
+		} `xml:"pluralRules"`
+		Ranges []struct {
+			Locales string        `xml:"locales,attr"`
+			Ranges  []pluralRange `xml:"pluralRange"`
+		} `xml:"pluralRanges"`
+	} `xml:"plurals"`
+
+	ParentLocales []struct {
+		// Component is set for the parents of a single kind of data,
+		// "segmentations" for instance, not the locale inheritance.
+		Component string `xml:"component,attr"`
+		Parents   []struct {
+			Parent  string `xml:"parent,attr"`
+			Locales string `xml:"locales,attr"`
+		} `xml:"parentLocale"`
+	} `xml:"parentLocales"`
+
+	LikelySubtags []struct {
+		From string `xml:"from,attr"`
+		To   string `xml:"to,attr"`
+	} `xml:"likelySubtags>likelySubtag"`
+
+	LanguageAliases []struct {
+		Type        string `xml:"type,attr"`
+		Replacement string `xml:"replacement,attr"`
+		Reason      string `xml:"reason,attr"`
+	} `xml:"metadata>alias>languageAlias"`
+}
+
+// pluralRange is the category of a range of numbers, "1-2" being "other" in
+// English, from pluralRanges.xml.
+type pluralRange struct {
+	Start  string `xml:"start,attr"`
+	End    string `xml:"end,attr"`
+	Result string `xml:"result,attr"`
+}
+
+// ldmlDir returns the directory of the supplemental XML files of dir, either
+// a CLDR checkout or its common/supplemental directory.
+func ldmlDir(dir string) string {
+	supplemental := filepath.Join(dir, "common", "supplemental")
+	if info, err := os.Stat(supplemental); nil == err && info.IsDir() {
+		return supplemental
+	}
+	return dir
+}
+
+// decodeLDML reads an LDML supplemental document.
+func decodeLDML(contents []byte) (*ldmlSupplemental, error) {
+	var document ldmlSupplemental
+	if err := xml.Unmarshal(contents, &document); nil != err {
+		return nil, err
+	}
+	if "supplementalData" != document.XMLName.Local {
+		return nil, fmt.Errorf("Data does not appear to be CLDR data")
+	}
+	return &document, nil
+}
+
+// readLDML reads the given supplemental XML file of a CLDR checkout.
+func readLDML(dir, file string) (*ldmlSupplemental, error) {
+	path := filepath.Join(ldmlDir(dir), file)
+	contents, err := ioutil.ReadFile(path)
+	if nil != err {
+		return nil, err
+	}
+	document, err := decodeLDML(contents)
+	if nil != err {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return document, nil
+}
+
+// localeIDs returns the locales of an attribute, "pt_AO pt_CH", as the keys
+// of cldr-json, "pt-AO" and "pt-CH".
+func localeIDs(locales string) []string {
+	ids := strings.Fields(locales)
+	for i, id := range ids {
+		ids[i] = strings.Replace(id, "_", "-", -1)
+	}
+	return ids
+}
+
+// rules returns the "plurals type=key" rules of the document as decodeCLDR
+// does, keyed by locale then by "pluralRule-count-"+category, and its
+// version.
+func (d *ldmlSupplemental) rules(key string) (map[string]map[string]string, string) {
+	data := make(map[string]map[string]string)
+	for _, plurals := range d.Plurals {
+		if key != plurals.Type {
+			continue
+		}
+		for _, rules := range plurals.Rules {
+			for _, locale := range localeIDs(rules.Locales) {
+				data[locale] = make(map[string]string, len(rules.Rules))
+				for _, r := range rules.Rules {
+					data[locale]["pluralRule-count-"+r.Count] = r.Rule
+				}
+			}
+		}
+	}
+	return data, d.Version.Number
+}
+
+// ranges returns the plural ranges of the document by locale.
+func (d *ldmlSupplemental) ranges() map[string][]pluralRange {
+	data := make(map[string][]pluralRange)
+	for _, plurals := range d.Plurals {
+		for _, ranges := range plurals.Ranges {
+			for _, locale := range localeIDs(ranges.Locales) {
+				data[locale] = ranges.Ranges
+			}
+		}
+	}
+	return data
+}
+
+// getLDML reads the "plurals type=key" rules of plurals.xml or ordinals.xml,
+// as get does for the cldr-json files.
+func getLDML(dir, file, key string, headers *string) (map[string]map[string]string, error) {
+	log.Print("READ ", filepath.Join(ldmlDir(dir), file))
+
+	document, err := readLDML(dir, file)
+	if nil != err {
+		return nil, err
+	}
+	data, version := document.rules(key)
+	if 0 == len(data) {
+		return nil, fmt.Errorf("%s: no %s rules", file, key)
+	}
+	*headers += ldmlHeader(file, version)
+	return data, nil
+}
+
+// getRules returns the "plurals-type-"+key rules, those of the -cldr
+// checkout when set, otherwise those of cldr-json.
+func getRules(key string, headers *string) (map[string]map[string]string, error) {
+	file := "plurals"
+	if "ordinal" == key {
+		file = "ordinals"
+	}
+	if "" != *cldr_dir {
+		return getLDML(*cldr_dir, file+".xml", key, headers)
+	}
+	return get("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/"+file+".json", key, headers)
+}
+
+// ldmlHeader is the header of the generated files telling a supplemental
+// XML file they come from.
+func ldmlHeader(file, version string) string {
+	return fmt.Sprintf("//\n// File: common/supplemental/%s\n// %s\n", file, version)
+}
+
+// getLDMLRanges reads pluralRanges.xml.
+func getLDMLRanges(dir string) (map[string][]pluralRange, error) {
+	log.Print("READ ", filepath.Join(ldmlDir(dir), "pluralRanges.xml"))
+
+	document, err := readLDML(dir, "pluralRanges.xml")
+	if nil != err {
+		return nil, err
+	}
+	return document.ranges(), nil
+}
+
+// getLDMLLocales reads the locale data of getLocales from
+// supplementalData.xml, likelySubtags.xml and supplementalMetadata.xml.
+func getLDMLLocales(dir string, headers *string) (*localeData, error) {
+	d := &localeData{map[string]string{}, map[string]string{}, map[string]string{}}
+
+	document, err := readLDML(dir, "supplementalData.xml")
+	if nil != err {
+		return nil, err
+	}
+	*headers += ldmlHeader("supplementalData.xml", document.Version.Number)
+	for _, parents := range document.ParentLocales {
+		if "" != parents.Component {
+			continue
+		}
+		for _, parent := range parents.Parents {
+			for _, locale := range localeIDs(parent.Locales) {
+				d.Parents[locale] = parent.Parent
+			}
+		}
+	}
+
+	if document, err = readLDML(dir, "likelySubtags.xml"); nil != err {
+		return nil, err
+	}
+	*headers += ldmlHeader("likelySubtags.xml", document.Version.Number)
+	for _, likely := range document.LikelySubtags {
+		d.LikelySubtags[likely.From] = likely.To
+	}
+
+	if document, err = readLDML(dir, "supplementalMetadata.xml"); nil != err {
+		return nil, err
+	}
+	*headers += ldmlHeader("supplementalMetadata.xml", document.Version.Number)
+	for _, alias := range document.LanguageAliases {
+		// as in getLocales
+		if strings.ContainsAny(alias.Type, "_-") || !aliasReasons[alias.Reason] {
+			continue
+		}
+		d.Aliases[alias.Type] = strings.Fields(alias.Replacement + " ")[0]
+	}
+
+	return &localeData{normalizeTags(d.Parents), normalizeTags(d.LikelySubtags), normalizeTags(d.Aliases)}, nil
+}
+
+// checkRanges returns the plural ranges of the locales using a category
+// their cardinal rules do not have, sorted by locale. Plural ranges are not
+// generated, so these are warnings.
+func checkRanges(ranges map[string][]pluralRange, allPlurals map[string]map[string]string) Issues {
+	locales := make([]string, 0, len(ranges))
+	for locale := range ranges {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var issues Issues
+	for _, locale := range locales {
+		plurals, ok := allPlurals[locale]
+		if !ok {
+			issues = append(issues, &Issue{locale, Warning, fmt.Errorf("%w: plural ranges but no cardinal rules", ErrNotDefined)})
+			continue
+		}
+		for _, r := range ranges[locale] {
+			for _, category := range []string{r.Start, r.End, r.Result} {
+				if _, ok := plurals["pluralRule-count-"+category]; !ok {
+					issues = append(issues, &Issue{locale, Warning, fmt.Errorf("range %s-%s: unknown category `%s`", r.Start, r.End, category)})
+					break
+				}
+			}
+		}
+	}
+	return issues
+}
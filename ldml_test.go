@@ -0,0 +1,174 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// testLDML is a CLDR checkout holding a few locales of each supplemental
+// file, testCLDRJSON the same plural rules as cldr-json.
+const (
+	testLDML     = "testdata/ldml"
+	testCLDRJSON = "testdata/cldr-json"
+)
+
+// readCLDRJSON returns the rules of a cldr-json file of testCLDRJSON, as get
+// does.
+func readCLDRJSON(t *testing.T, file, key string) (map[string]map[string]string, string) {
+	contents, err := ioutil.ReadFile(filepath.Join(testCLDRJSON, file))
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	data, version, err := decodeCLDR(contents, key)
+	if nil != err {
+		t.Fatalf("`%s` unexpected error: %s", file, err.Error())
+	}
+	return data, version
+}
+
+// readLDMLRules returns the rules of a supplemental XML file of testLDML, as
+// getLDML does.
+func readLDMLRules(t *testing.T, file, key string) (map[string]map[string]string, string) {
+	document, err := readLDML(testLDML, file)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	data, version := document.rules(key)
+	return data, version
+}
+
+func TestLDMLMatchesCLDRJSON(t *testing.T) {
+	xmlPlurals, xmlVersion := readLDMLRules(t, "plurals.xml", "cardinal")
+	xmlOrdinals, _ := readLDMLRules(t, "ordinals.xml", "ordinal")
+	jsonPlurals, jsonVersion := readCLDRJSON(t, "plurals.json", "cardinal")
+	jsonOrdinals, _ := readCLDRJSON(t, "ordinals.json", "ordinal")
+
+	if xmlVersion != jsonVersion {
+		t.Errorf("Version expecting <%s> but got <%s>", jsonVersion, xmlVersion)
+	}
+	if !reflect.DeepEqual(xmlPlurals, jsonPlurals) {
+		t.Errorf("Cardinal rules expecting %v but got %v", jsonPlurals, xmlPlurals)
+	}
+	if !reflect.DeepEqual(xmlOrdinals, jsonOrdinals) {
+		t.Errorf("Ordinal rules expecting %v but got %v", jsonOrdinals, xmlOrdinals)
+	}
+
+	for _, locale := range []string{"en", "fr", "it", "ja", "pt", "pt-PT", "xh"} {
+		if _, ok := xmlPlurals[locale]; !ok {
+			t.Errorf("`%s` expecting cardinal rules", locale)
+			continue
+		}
+		fromXML, err := newCulture(locale, xmlOrdinals[locale], xmlPlurals[locale])
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", locale, err.Error())
+			continue
+		}
+		fromJSON, err := newCulture(locale, jsonOrdinals[locale], jsonPlurals[locale])
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", locale, err.Error())
+			continue
+		}
+		if !reflect.DeepEqual(fromXML, fromJSON) {
+			t.Errorf("`%s` expecting %+v but got %+v", locale, fromJSON, fromXML)
+		}
+	}
+}
+
+func TestCultureExponent(t *testing.T) {
+	plurals, _ := readLDMLRules(t, "plurals.xml", "cardinal")
+	ordinals, _ := readLDMLRules(t, "ordinals.xml", "ordinal")
+	culture, err := newCulture("fr", ordinals["fr"], plurals["fr"])
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if plural.E != culture.E {
+		t.Errorf("`fr` expecting the e operand")
+	}
+	if vars, _ := culture2code(&culture, true); !strings.Contains(vars, "e := o.E") {
+		t.Errorf("`fr` expecting e to be declared but got %q", vars)
+	}
+
+	// c is a synonym of e
+	for _, operand := range []byte{'c', 'e'} {
+		if s, err := toSymbol(operand); nil != err || plural.E != s {
+			t.Errorf("`%c` expecting <E> but got <%s> (%v)", operand, s, err)
+		}
+	}
+	if _, err := toSymbol('x'); !errors.Is(err, ErrUnknownOperand) {
+		t.Errorf("`x` expecting ErrUnknownOperand but got %v", err)
+	}
+}
+
+func TestGetLDMLLocales(t *testing.T) {
+	var headers string
+	d, err := getLDMLLocales(testLDML, &headers)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	expected := &localeData{
+		// the parents of a component, segmentations, are left out
+		Parents:       map[string]string{"az-Arab": "root", "pt-AO": "pt-PT", "pt-CH": "pt-PT"},
+		LikelySubtags: map[string]string{"en": "en-Latn-US", "pt": "pt-Latn-BR", "sr-ME": "sr-Latn-ME"},
+		// the overlong eng is left out, sh keeps its first replacement
+		Aliases: map[string]string{"iw": "he", "sh": "sr-Latn"},
+	}
+	if !reflect.DeepEqual(expected, d) {
+		t.Errorf("Expecting %+v but got %+v", expected, d)
+	}
+	for _, file := range []string{"supplementalData.xml", "likelySubtags.xml", "supplementalMetadata.xml"} {
+		if !strings.Contains(headers, "// File: common/supplemental/"+file+"\n") {
+			t.Errorf("`%s` missing from the headers %q", file, headers)
+		}
+	}
+}
+
+func TestLDMLDir(t *testing.T) {
+	supplemental := filepath.Join(testLDML, "common", "supplemental")
+	for _, dir := range []string{testLDML, supplemental} {
+		if result := ldmlDir(dir); supplemental != result {
+			t.Errorf("`%s` expecting <%s> but got <%s>", dir, supplemental, result)
+		}
+	}
+
+	if _, err := readLDML(testLDML, "missing.xml"); nil == err {
+		t.Errorf("`missing.xml` expecting an error")
+	}
+	for _, contents := range []string{`<ldml/>`, `<supplementalData>`} {
+		if _, err := decodeLDML([]byte(contents)); nil == err {
+			t.Errorf("`%s` expecting an error", contents)
+		}
+	}
+}
+
+func TestCheckRanges(t *testing.T) {
+	ranges, err := getLDMLRanges(testLDML)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if 3 != len(ranges["en"]) || !reflect.DeepEqual(ranges["en"], ranges["xh"]) {
+		t.Errorf("Unexpected ranges %v", ranges)
+	}
+
+	plurals, _ := readLDMLRules(t, "plurals.xml", "cardinal")
+	issues := checkRanges(ranges, plurals)
+	if 1 != len(issues) || "fr" != issues[0].Locale || !strings.Contains(issues[0].Error(), "range one-few: unknown category `few`") {
+		t.Errorf("Expecting the unknown category of fr but got %v", issues)
+	}
+	// plural ranges are not generated, only -strict fails on them
+	if issues.Failed(false) || !issues.Failed(true) {
+		t.Errorf("Expecting warnings but got %v", issues)
+	}
+
+	delete(plurals, "xh")
+	issues = checkRanges(ranges, plurals)
+	if 2 != len(issues) || "xh" != issues[1].Locale || Warning != issues[1].Severity || !errors.Is(issues[1], ErrNotDefined) {
+		t.Errorf("`xh` expecting ErrNotDefined but got %v", issues)
+	}
+}
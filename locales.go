@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// localeData holds the CLDR data the resolver of the "plural" package needs
+// to follow the locale inheritance: the parent locales and the likely
+// subtags, both keyed by BCP 47 tags, and the aliases of the deprecated and
+// legacy language codes.
+type localeData struct {
+	Parents       map[string]string
+	LikelySubtags map[string]string
+	Aliases       map[string]string
+}
+
+// languageAlias is an entry of the languageAlias of aliases.json.
+type languageAlias struct {
+	Replacement string `json:"_replacement"`
+	Reason      string `json:"_reason"`
+}
+
+// aliasReasons are the reasons of the aliases kept: the overlong and
+// bibliographic codes, "heb" or "fre", are replaced by golang.org/x/text
+// anyway.
+var aliasReasons = map[string]bool{"deprecated": true, "legacy": true, "macrolanguage": true}
+
+// getLocales fetches parentLocales.json, likelySubtags.json and aliases.json.
+func getLocales(headers *string) (*localeData, error) {
+	var parents struct {
+		ParentLocale map[string]string `json:"parentLocale"`
+	}
+	err := getSupplemental("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/parentLocales.json", "parentLocales", &parents, headers)
+	if nil != err {
+		return nil, err
+	}
+
+	var likely map[string]string
+	err = getSupplemental("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/likelySubtags.json", "likelySubtags", &likely, headers)
+	if nil != err {
+		return nil, err
+	}
+
+	var metadata struct {
+		Alias struct {
+			LanguageAlias map[string]languageAlias `json:"languageAlias"`
+		} `json:"alias"`
+	}
+	err = getSupplemental("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/aliases.json", "metadata", &metadata, headers)
+	if nil != err {
+		return nil, err
+	}
+
+	aliases := make(map[string]string, len(metadata.Alias.LanguageAlias))
+	for code, alias := range metadata.Alias.LanguageAlias {
+		// "sgn_BR" and the like alias locales, not language codes, and
+		// some codes have several replacements, "sh" the first of them
+		if strings.ContainsAny(code, "_-") || !aliasReasons[alias.Reason] {
+			continue
+		}
+		aliases[code] = strings.Fields(alias.Replacement + " ")[0]
+	}
+	return &localeData{normalizeTags(parents.ParentLocale), normalizeTags(likely), normalizeTags(aliases)}, nil
+}
+
+// getSupplemental decodes the given key of a CLDR supplemental JSON document
+// into v.
+func getSupplemental(url, key string, v interface{}, headers *string) error {
+	contents, err := fetch(url)
+	if nil != err {
+		return err
+	}
+
+	var document map[string]map[string]json.RawMessage
+	err = json.Unmarshal(contents, &document)
+	if nil != err {
+		return err
+	}
+	if _, ok := document["supplemental"]; !ok {
+		return fmt.Errorf("Data does not appear to be CLDR data")
+	}
+
+	var version map[string]string
+	err = json.Unmarshal(document["supplemental"]["version"], &version)
+	if nil != err {
+		return err
+	}
+	if err = json.Unmarshal(document["supplemental"][key], v); nil != err {
+		return err
+	}
+	*headers += fmt.Sprintf("//\n// URL: %s\n", url)
+	*headers += fmt.Sprintf("// %s\n", version["_number"])
+	return nil
+}
+
+// normalizeTags returns tags written as CLDR locale ids, "pt_AO", as BCP 47
+// tags.
+func normalizeTags(tags map[string]string) map[string]string {
+	normalized := make(map[string]string, len(tags))
+	for key, value := range tags {
+		normalized[strings.Replace(key, "_", "-", -1)] = strings.Replace(value, "_", "-", -1)
+	}
+	return normalized
+}
+
+// filter keeps the locale data affecting the resolution of the given
+// cultures: the only locales whose rules differ from those of their language
+// have a region or a script, "pt-PT", so the parent locales leading to them
+// and the likely subtags of their languages are kept, the others resolving
+// to their language anyway. So are the aliases replacing a code by a
+// language having rules.
+func (d *localeData) filter(cultures []string) *localeData {
+	keys := make(map[string]bool, len(cultures))
+	langs := make(map[language.Base]bool)
+	for _, culture := range cultures {
+		tag, err := language.Parse(culture)
+		if nil != err {
+			continue
+		}
+		if base, script, region := tag.Raw(); (language.Script{}) != script || (language.Region{}) != region {
+			keys[tag.String()] = true
+			langs[base] = true
+		}
+	}
+
+	filtered := &localeData{map[string]string{}, map[string]string{}, map[string]string{}}
+	for child, parent := range d.Parents {
+		// bounded, should the data ever hold a cycle
+		for p, depth := parent, 0; "" != p && depth < 8; p, depth = d.Parents[p], depth+1 {
+			if keys[p] {
+				filtered.Parents[child] = parent
+				break
+			}
+		}
+	}
+
+	// scriptOf returns the script of a tag, the zero script without one
+	scriptOf := func(tag string) language.Script {
+		t, err := language.Parse(tag)
+		if nil != err {
+			return language.Script{}
+		}
+		_, script, _ := t.Raw()
+		return script
+	}
+	for key, likely := range d.LikelySubtags {
+		tag, err := language.Parse(key)
+		if nil != err {
+			continue
+		}
+		base, script, region := tag.Raw()
+		if !langs[base] || (language.Script{}) != script {
+			continue
+		}
+		// the likely script of a region matters when it is not the one of
+		// the language, "sr-ME" being "sr-Latn-ME"
+		if (language.Region{}) == region || scriptOf(likely) != scriptOf(d.LikelySubtags[base.String()]) {
+			filtered.LikelySubtags[key] = likely
+		}
+	}
+
+	// the aliases of the languages having rules, whose own rules, if any,
+	// are those of the replacement
+	bases := make(map[language.Base]bool, len(cultures))
+	for _, culture := range cultures {
+		if _, ok := d.Aliases[culture]; ok {
+			continue
+		}
+		if tag, err := language.Parse(culture); nil == err {
+			base, _, _ := tag.Raw()
+			bases[base] = true
+		}
+	}
+	for code, replacement := range d.Aliases {
+		// codes golang.org/x/text does not know never reach the lookups
+		if _, err := language.Raw.Parse(code); nil != err {
+			continue
+		}
+		tag, err := language.Parse(replacement)
+		if nil != err {
+			continue
+		}
+		if base, _, _ := tag.Raw(); bases[base] {
+			filtered.Aliases[code] = replacement
+		}
+	}
+	return filtered
+}
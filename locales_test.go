@@ -0,0 +1,104 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocaleDataFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     localeData
+		cultures []string
+		expected localeData
+	}{
+		{
+			name: "parent locales",
+			data: localeData{Parents: map[string]string{
+				"pt-AO":   "pt-PT",
+				"pt-MZ":   "pt-PT",
+				"es-AR":   "es-419",
+				"az-Arab": "root",
+			}},
+			// pt-AO resolves to pt-PT, whose own parent is pt
+			cultures: []string{"es", "pt", "pt-PT"},
+			expected: localeData{Parents: map[string]string{"pt-AO": "pt-PT", "pt-MZ": "pt-PT"}},
+		},
+		{
+			name: "parent chain",
+			data: localeData{Parents: map[string]string{
+				"en-150": "en-001",
+				"en-AT":  "en-150",
+				"en-IN":  "en-001",
+				"en-001": "root",
+			}},
+			cultures: []string{"en", "en-001"},
+			expected: localeData{Parents: map[string]string{"en-150": "en-001", "en-AT": "en-150", "en-IN": "en-001"}},
+		},
+		{
+			name: "parent cycle",
+			data: localeData{Parents: map[string]string{
+				"pt-AO": "pt-MZ",
+				"pt-MZ": "pt-AO",
+			}},
+			cultures: []string{"pt", "pt-PT"},
+			expected: localeData{Parents: map[string]string{}},
+		},
+		{
+			name: "likely subtags",
+			data: localeData{LikelySubtags: map[string]string{
+				"en":      "en-Latn-US",
+				"pt":      "pt-Latn-BR",
+				"pt-AO":   "pt-Latn-AO",
+				"sr":      "sr-Cyrl-RS",
+				"sr-ME":   "sr-Latn-ME",
+				"sr-RS":   "sr-Cyrl-RS",
+				"sr-Latn": "sr-Latn-RS",
+			}},
+			// only the languages of pt-PT and sr-Latn keep theirs, sr-ME
+			// having another script than sr
+			cultures: []string{"en", "pt", "pt-PT", "sr", "sr-Latn"},
+			expected: localeData{LikelySubtags: map[string]string{
+				"pt":    "pt-Latn-BR",
+				"sr":    "sr-Cyrl-RS",
+				"sr-ME": "sr-Latn-ME",
+			}},
+		},
+		{
+			name: "aliases",
+			data: localeData{Aliases: map[string]string{
+				"iw":        "he",
+				"mo":        "ro-MD",
+				"sh":        "sr-Latn",
+				"tl":        "fil",
+				"in":        "id",
+				"aaaaaaaaa": "he",
+			}},
+			// in has its own rules, those of id, which are not generated
+			cultures: []string{"he", "in", "ro", "ro-MD", "sr"},
+			expected: localeData{Aliases: map[string]string{
+				"iw": "he",
+				"mo": "ro-MD",
+				"sh": "sr-Latn",
+			}},
+		},
+	}
+	for _, test := range tests {
+		for _, m := range []*map[string]string{&test.expected.Parents, &test.expected.LikelySubtags, &test.expected.Aliases} {
+			if nil == *m {
+				*m = map[string]string{}
+			}
+		}
+		if result := test.data.filter(test.cultures); !reflect.DeepEqual(&test.expected, result) {
+			t.Errorf("`%s` expecting %+v but got %+v", test.name, test.expected, *result)
+		}
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags := map[string]string{"pt_AO": "pt_PT", "sr_ME": "sr_Latn_ME", "en": "en_Latn_US"}
+	expected := map[string]string{"pt-AO": "pt-PT", "sr-ME": "sr-Latn-ME", "en": "en-Latn-US"}
+	if result := normalizeTags(tags); !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expecting %v but got %v", expected, result)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -22,6 +23,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/louischan-oursky/gomakeplural/plural"
+	"github.com/louischan-oursky/gomakeplural/plural/verify"
 )
 
 type (
@@ -49,6 +51,11 @@ type (
 		expected, value string
 	}
 
+	BenchmarkSource struct {
+		class, culture    string
+		cardinal, ordinal []string
+	}
+
 	Op struct {
 		previous_logic, left, operator, right, next_logic string
 	}
@@ -90,7 +97,52 @@ func (x UnitTestSource) Code() string {
 func NewTestSource(name string, culture *plural.Culture) UnitTestSource {
 	tests1 := NewTests(culture.Tests.Cardinal, false)
 	tests2 := NewTests(culture.Tests.Ordinal, true)
-	return UnitTestSource{name, append(tests1, tests2...)}
+	tests3 := NewOrdinalValidityTests(culture)
+	tests4 := NewNoOrdinalTests(culture)
+	return UnitTestSource{name, append(append(append(tests1, tests2...), tests3...), tests4...)}
+}
+
+func (x BenchmarkSource) Culture() string {
+	return x.culture
+}
+
+func (x BenchmarkSource) CultureId() string {
+	return sanitize(x.culture)
+}
+
+// Class names the rules of the culture after their cardinal and ordinal
+// categories, "one,other;other" for en.
+func (x BenchmarkSource) Class() string {
+	return x.class
+}
+
+func (x BenchmarkSource) Code() string {
+	samples := func(values []string) string {
+		if 0 == len(values) {
+			return "nil"
+		}
+		return fmt.Sprintf("%#v", values)
+	}
+	return samples(x.cardinal) + ", " + samples(x.ordinal)
+}
+
+// benchmarksPerClass is the number of locales benchmarked for a class of
+// rules.
+const benchmarksPerClass = 3
+
+// NewBenchmarkSource benchmarks the plural function of a culture on all its
+// samples.
+func NewBenchmarkSource(culture *plural.Culture) BenchmarkSource {
+	samples := func(uts []plural.UnitTest) []string {
+		var result []string
+		for _, ut := range uts {
+			result = append(result, ut.Integers...)
+			result = append(result, ut.Decimals...)
+		}
+		return result
+	}
+	class := strings.Join(categories(culture.Cardinal), ",") + ";" + strings.Join(categories(culture.Ordinal), ",")
+	return BenchmarkSource{class, culture.Langs[0], samples(culture.Tests.Cardinal), samples(culture.Tests.Ordinal)}
 }
 
 func NewTests(uts []plural.UnitTest, ordinal bool) []Test {
@@ -102,7 +154,7 @@ func NewTests(uts []plural.UnitTest, ordinal bool) []Test {
 	tests := make([]Test, 0, length)
 	for _, ut := range uts {
 		for _, v := range ut.Integers {
-			tests = append(tests, UnitTest{ordinal, ut.Expected, v})
+			tests = append(tests, UnitTest{ordinal, ut.Expected, integerLiteral(v)})
 		}
 		for _, v := range ut.Decimals {
 			tests = append(tests, UnitTest{ordinal, ut.Expected, `"` + v + `"`})
@@ -111,6 +163,53 @@ func NewTests(uts []plural.UnitTest, ordinal bool) []Test {
 	return tests
 }
 
+// integerLiteral returns an integer sample as a Go literal, compact ones such
+// as "1c6" being left strings.
+func integerLiteral(v string) string {
+	if strings.ContainsAny(v, "ce") {
+		return `"` + v + `"`
+	}
+	return v
+}
+
+// NewOrdinalValidityTests checks that the first integer sample of every
+// ordinal category keeps it once written with a zero fraction, while the same
+// number with a non-zero fraction is "other".
+func NewOrdinalValidityTests(culture *plural.Culture) []Test {
+	if !culture.HasOrdinal() {
+		return nil
+	}
+
+	var tests []Test
+	for _, ut := range culture.Tests.Ordinal {
+		if 0 == len(ut.Integers) {
+			continue
+		}
+		tests = append(tests,
+			UnitTest{true, ut.Expected, `"` + ut.Integers[0] + `.0"`},
+			UnitTest{true, "other", `"` + ut.Integers[0] + `.5"`},
+		)
+	}
+	return tests
+}
+
+// NewNoOrdinalTests checks that a culture without CLDR ordinal data has only
+// "other" ordinals, using the first integer sample of every cardinal
+// category.
+func NewNoOrdinalTests(culture *plural.Culture) []Test {
+	if culture.OrdinalData {
+		return nil
+	}
+
+	var tests []Test
+	for _, ut := range culture.Tests.Cardinal {
+		if 0 != len(ut.Integers) && "other" != ut.Expected {
+			tests = append(tests, UnitTest{true, "other", integerLiteral(ut.Integers[0])})
+		}
+	}
+	return tests
+}
+
 func (x UnitTest) toString() string {
 	return fmt.Sprintf(
 		"testNamedKey(t, fn, %s, `%s`, `%s`, %v)",
@@ -158,6 +257,21 @@ func (x Op) conditions(culture *plural.Culture) []string {
 }
 
 func get(url, key string, headers *string) (map[string]map[string]string, error) {
+	contents, err := fetch(url)
+	if nil != err {
+		return nil, err
+	}
+
+	data, version, err := decodeCLDR(contents, key)
+	if nil != err {
+		return nil, err
+	}
+	*headers += fmt.Sprintf("//\n// URL: %s\n", url)
+	*headers += fmt.Sprintf("// %s\n", version)
+	return data, nil
+}
+
+func fetch(url string) ([]byte, error) {
 	log.Print("GET ", url)
 
 	response, err := http.Get(url)
@@ -169,35 +283,34 @@ func get(url, key string, headers *string) (map[string]map[string]string, error)
 	if 200 != response.StatusCode {
 		return nil, fmt.Errorf(response.Status)
 	}
+	return ioutil.ReadAll(response.Body)
+}
 
-	contents, err := ioutil.ReadAll(response.Body)
-
+// decodeCLDR returns the "plurals-type-"+key rules of a CLDR supplemental
+// JSON document, plurals.json or ordinals.json, and the CLDR version.
+func decodeCLDR(contents []byte, key string) (map[string]map[string]string, string, error) {
 	var document map[string]map[string]json.RawMessage
-	err = json.Unmarshal([]byte(contents), &document)
+	err := json.Unmarshal(contents, &document)
 	if nil != err {
-		return nil, err
+		return nil, "", err
 	}
 
 	if _, ok := document["supplemental"]; !ok {
-		return nil, fmt.Errorf("Data does not appear to be CLDR data")
+		return nil, "", fmt.Errorf("Data does not appear to be CLDR data")
 	}
-	*headers += fmt.Sprintf("//\n// URL: %s\n", url)
 
-	{
-		var version map[string]string
-		err = json.Unmarshal(document["supplemental"]["version"], &version)
-		if nil != err {
-			return nil, err
-		}
-		*headers += fmt.Sprintf("// %s\n", version["_number"])
+	var version map[string]string
+	err = json.Unmarshal(document["supplemental"]["version"], &version)
+	if nil != err {
+		return nil, "", err
 	}
 
 	var data map[string]map[string]string
 	err = json.Unmarshal(document["supplemental"]["plurals-type-"+key], &data)
 	if nil != err {
-		return nil, err
+		return nil, "", err
 	}
-	return data, nil
+	return data, version["_number"], nil
 }
 
 func rangeCondition(varname string, lower, upper int, operator string) string {
@@ -207,10 +320,11 @@ func rangeCondition(varname string, lower, upper int, operator string) string {
 	return fmt.Sprintf("%s >= %d && %s <= %d", varname, lower, varname, upper)
 }
 
-func pattern2code(input string, culture *plural.Culture) []string {
+func pattern2code(input string, culture *plural.Culture) ([]string, error) {
 	left, short, operator, logic := "", "", "", ""
 
 	var ops []Op
+	var err error
 	buf := ""
 loop:
 	for _, char := range input {
@@ -226,12 +340,16 @@ loop:
 		case '=':
 			if "" != buf {
 				left, operator, buf = buf, "==", ""
-				short = toVar(left, culture)
+				if short, err = toVar(left, culture); nil != err {
+					return nil, err
+				}
 			}
 
 		case '!':
 			left, operator, buf = buf, "!=", ""
-			short = toVar(left, culture)
+			if short, err = toVar(left, culture); nil != err {
+				return nil, err
+			}
 		}
 
 		if "" != buf {
@@ -258,9 +376,9 @@ loop:
 	if 1 == len(ops) {
 		conditions := ops[0].conditions(culture)
 		if "==" == ops[0].operator {
-			return conditions
+			return conditions, nil
 		} else {
-			return []string{joinAnd(conditions)}
+			return []string{joinAnd(conditions)}, nil
 		}
 	}
 
@@ -327,7 +445,7 @@ loop:
 			result = append(result, joinAnd(buffer))
 		}
 	}
-	return result
+	return result, nil
 }
 
 func joinTo(data []string, idx int, toAppend string) {
@@ -362,67 +480,45 @@ func joinOr(data []string) string {
 	return data[0]
 }
 
-func rule2code(key string, data map[string]string, padding string, culture *plural.Culture, ordinal bool) string {
+func parseRule(key string, data map[string]string, culture *plural.Culture, ordinal bool) error {
 	if input, ok := data["pluralRule-count-"+key]; ok {
-		result := ""
-
-		if "other" == key {
-			if 1 == len(data) {
-				return "return \"other\"\n"
-			}
-			result += "default:\n"
+		conds, err := pattern2code(input, culture)
+		if nil != err {
+			return &RuleError{key, ordinal, input, err}
+		}
+		cond := strings.Join(conds, " || ")
+		if ordinal {
+			culture.Ordinal = append(culture.Ordinal, plural.Case{Form: key, Cond: cond})
 		} else {
-			cond := strings.Join(pattern2code(input, culture), " || ")
-			if ordinal {
-				culture.Ordinal = append(culture.Ordinal, plural.Case{Form: key, Cond: cond})
-			} else {
-				culture.Cardinal = append(culture.Cardinal, plural.Case{Form: key, Cond: cond})
-			}
-			result += "\n" + "case " + cond + ":\n"
+			culture.Cardinal = append(culture.Cardinal, plural.Case{Form: key, Cond: cond})
 		}
-		result += "\treturn \"" + key + "\"\n"
-		return result
 	}
-	return ""
+	return nil
 }
 
-func map2code(data map[string]string, padding string, culture *plural.Culture, ordinal bool) string {
+func parseRules(data map[string]string, culture *plural.Culture, ordinal bool) error {
 	if 1 == len(data) {
-		return rule2code("other", data, padding, culture, ordinal)
+		return nil
 	}
-	result := "switch {\n"
-	result += rule2code("other", data, padding, culture, ordinal)
-	result += rule2code("zero", data, padding, culture, ordinal)
-	result += rule2code("one", data, padding, culture, ordinal)
-	result += rule2code("two", data, padding, culture, ordinal)
-	result += rule2code("few", data, padding, culture, ordinal)
-	result += rule2code("many", data, padding, culture, ordinal)
-	result += "}\n"
-	return result
-}
-
-func splitValues(input string) []string {
-	var result []string
-
-	pos := -1
-	for idx, char := range input {
-		switch {
-		case (char >= '0' && char <= '9') || '.' == char:
-			if -1 == pos {
-				pos = idx
-			}
-
-		case ' ' == char || ',' == char || '~' == char:
-			if -1 != pos {
-				result = append(result, input[pos:idx])
-				pos = -1
-			}
+	for _, key := range []string{"zero", "one", "two", "few", "many"} {
+		if err := parseRule(key, data, culture, ordinal); nil != err {
+			return err
 		}
 	}
+	return nil
+}
 
-	if -1 != pos {
-		result = append(result, input[pos:])
+func cases2code(cases plural.Cases) string {
+	if 0 == len(cases) {
+		return "return Other\n"
 	}
+	result := "switch {\n"
+	result += "default:\n\treturn Other\n"
+	for _, c := range cases {
+		result += "\n" + "case " + c.Cond + ":\n"
+		result += "\treturn " + strings.ToUpper(c.Form[:1]) + c.Form[1:] + "\n"
+	}
+	result += "}\n"
 	return result
 }
 
@@ -431,9 +527,9 @@ func pattern2test(expected, input string, culture *plural.Culture, ordinal bool)
 	patterns := strings.Split(input, "@")
 	for _, pattern := range patterns {
 		if strings.HasPrefix(pattern, "integer") {
-			ut.Integers = splitValues(pattern[8:])
+			ut.Integers = verify.ExpandSamples(pattern[8:])
 		} else if strings.HasPrefix(pattern, "decimal") {
-			ut.Decimals = splitValues(pattern[8:])
+			ut.Decimals = verify.ExpandSamples(pattern[8:])
 		}
 	}
 	if ordinal {
@@ -455,107 +551,144 @@ func map2test(ordinals, plurals map[string]string, culture *plural.Culture) {
 	}
 }
 
-func culture2code(ordinals, plurals map[string]string, padding string, culture *plural.Culture) (string, string) {
-	var code string
+// newCulture parses the CLDR rules of culture, ordinals being nil without
+// ordinal data.
+func newCulture(culture string, ordinals, plurals map[string]string) (plural.Culture, error) {
+	data := plural.Culture{
+		Langs:       []string{culture},
+		Cardinal:    make(plural.Cases, 0, 5),
+		Ordinal:     make(plural.Cases, 0, 5),
+		OrdinalData: nil != ordinals,
+		Vars:        make([]plural.Var, 0, 8),
+	}
+	err := parseCulture(ordinals, plurals, &data)
+	return data, err
+}
 
-	if nil == ordinals {
-		code = map2code(plurals, padding, culture, false)
-	} else {
-		code = "if ordinal {\n"
-		code += map2code(ordinals, padding+"\t", culture, true)
-		code += "}\n\n"
-		code += map2code(plurals, padding, culture, false)
+func parseCulture(ordinals, plurals map[string]string, culture *plural.Culture) error {
+	if nil != ordinals {
+		if err := parseRules(ordinals, culture, true); nil != err {
+			return err
+		}
+	}
+	if err := parseRules(plurals, culture, false); nil != err {
+		return err
 	}
 	map2test(ordinals, plurals, culture)
 
-	str_vars := ""
-
 	if culture.HasVars() {
-		// http://unicode.org/reports/tr35/tr35-numbers.html#Operands
-		//
-		// Symbol	Value
-		// n	    absolute value of the source number (integer and decimals).
-		// i	    integer digits of n.
-		// v	    number of visible fraction digits in n, with trailing zeros.
-		// w	    number of visible fraction digits in n, without trailing zeros.
-		// f	    visible fractional digits in n, with trailing zeros.
-		// t	    visible fractional digits in n, without trailing zeros.
 		if culture.P.Use() && !culture.W.Use() {
 			culture.N = plural.N
 		}
+		if culture.NeedFinvtw() && culture.P.Use() {
+			culture.W = plural.W
+		}
+		if len(culture.Vars) == 0 {
+			culture.Vars = nil
+		}
+	}
+	return nil
+}
 
-		if culture.F.Use() || culture.V.Use() || culture.T.Use() || culture.W.Use() {
-			if culture.P.Use() {
-				culture.W = plural.W
-			}
-			str_vars += fmt.Sprintf("%s, %s, %s, %s, %s, %s := finvtw(value)\n",
-				getSymbolName(culture.F),
-				getSymbolName(culture.I),
-				getSymbolName(culture.N),
-				getSymbolName(culture.V),
-				getSymbolName(culture.T),
-				getSymbolName(culture.W))
-			if culture.P.Use() {
-				str_vars += "p := w == 0\n"
-			}
-		} else {
-			if culture.N.Use() {
-				if culture.I.Use() {
-					str_vars += "flt := float(value)\n"
-					str_vars += "n := math.Abs(flt)\n"
-					str_vars += "i := int64(flt)\n"
-				} else {
-					str_vars += "n := math.Abs(float(value))\n"
-				}
-			} else if culture.I.Use() {
-				str_vars += "i := int64(float(value))\n"
-			}
-			if culture.P.Use() {
-				if culture.I.Use() {
-					str_vars += "p := float64(i) == n\n"
-				} else {
-					str_vars += "p := float64(int64(n)) == n\n"
-				}
-			}
+// culture2code returns the variables and the body of the plural function of
+// a parsed culture, ordinal tells whether CLDR has ordinal data for it.
+func culture2code(culture *plural.Culture, ordinal bool) (string, string) {
+	var code string
+	if !ordinal && culture.HasCardinal() {
+		// without CLDR ordinal data, ordinals are only "other"
+		code = "if ordinal {\nreturn Other\n}\n\n"
+	} else if ordinal {
+		code = "if ordinal {\n"
+		if culture.HasOrdinal() {
+			// CLDR ordinals are only defined for integers
+			code += "if 0 != o.W {\nreturn Other\n}\n\n"
 		}
+		code += cases2code(culture.Ordinal)
+		code += "}\n\n"
+	}
+	code += cases2code(culture.Cardinal)
 
-		for _, v := range culture.Vars {
-			str_vars += v.Name() + " := " + toVarExpr(v) + "\n"
+	str_vars := ""
+
+	// http://unicode.org/reports/tr35/tr35-numbers.html#Operands
+	//
+	// Symbol	Value
+	// n	    absolute value of the source number (integer and decimals).
+	// i	    integer digits of n.
+	// v	    number of visible fraction digits in n, with trailing zeros.
+	// w	    number of visible fraction digits in n, without trailing zeros.
+	// f	    visible fractional digits in n, with trailing zeros.
+	// t	    visible fractional digits in n, without trailing zeros.
+	// e	    exponent of the compact decimal notation, also named c.
+	for _, s := range []plural.Symbol{culture.N, culture.I, culture.V, culture.W, culture.F, culture.T, culture.E} {
+		if s.Use() && (s != plural.N || usesN(culture)) {
+			str_vars += s.Name() + " := o." + s.String() + "\n"
 		}
-		if len(culture.Vars) == 0 {
-			culture.Vars = nil
+	}
+	if culture.P.Use() {
+		if culture.W.Use() {
+			str_vars += "p := w == 0\n"
+		} else {
+			str_vars += "p := o.W == 0\n"
 		}
 	}
+
+	for _, v := range culture.Vars {
+		str_vars += v.Name() + " := " + toVarExpr(v) + "\n"
+	}
 	return str_vars, code
 }
 
-func toVar(expr string, culture *plural.Culture) string {
+var nOperand = regexp.MustCompile(`\bn\b`)
+
+// usesN tells whether n is used by the conditions, rather than only through
+// its mod variables.
+func usesN(culture *plural.Culture) bool {
+	for _, cases := range []plural.Cases{culture.Cardinal, culture.Ordinal} {
+		for _, c := range cases {
+			if nOperand.MatchString(c.Cond) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func toVar(expr string, culture *plural.Culture) (string, error) {
 	var v plural.Var
 	if pos := strings.Index(expr, "%"); -1 != pos {
 		k, m := expr[:pos], expr[pos+1:]
 		if len(k) != 1 {
-			log.Fatalln("symbol mod err:", expr)
+			return "", fmt.Errorf("%w: `%s`", ErrInvalidOperand, expr)
 		}
 		mod, err := strconv.Atoi(m)
-		if err != nil {
-			log.Fatalf("mod err: %v\n", expr)
+		if err != nil || mod <= 0 {
+			return "", fmt.Errorf("%w: `%s`", ErrInvalidOperand, expr)
+		}
+		symbol, err := setSymbol(k[0], culture)
+		if nil != err {
+			return "", err
 		}
-		v = plural.Var{Symbol: setSymbol(k[0], culture), Mod: mod}
+		v = plural.Var{Symbol: symbol, Mod: mod}
 	} else {
 		if len(expr) != 1 {
-			log.Fatalf("symbol length err: %v\n", expr)
+			return "", fmt.Errorf("%w: `%s`", ErrInvalidOperand, expr)
 		}
-		return setSymbol(expr[0], culture).Name()
+		symbol, err := setSymbol(expr[0], culture)
+		if nil != err {
+			return "", err
+		}
+		return symbol.Name(), nil
 	}
 
 	for _, e := range culture.Vars {
 		if e == v {
-			return v.Name()
+			return v.Name(), nil
 		}
 	}
 
 	culture.Vars = append(culture.Vars, v)
-	return v.Name()
+	return v.Name(), nil
 }
 
 var symbols = map[byte]bool{
@@ -565,18 +698,27 @@ var symbols = map[byte]bool{
 	'v': true,
 	't': true,
 	'w': true,
+	'e': true,
+	'c': true,
 	'p': true,
 }
 
-func toSymbol(s byte) plural.Symbol {
+func toSymbol(s byte) (plural.Symbol, error) {
 	if !symbols[s] {
-		log.Fatalf("toSymbol err: %s\n", string(s))
+		return plural.U, fmt.Errorf("%w: `%c`", ErrUnknownOperand, s)
 	}
-	return plural.Symbol(s)
+	if 'c' == s {
+		// c and e are synonyms
+		return plural.E, nil
+	}
+	return plural.Symbol(s), nil
 }
 
-func setSymbol(s byte, culture *plural.Culture) plural.Symbol {
-	b := toSymbol(s)
+func setSymbol(s byte, culture *plural.Culture) (plural.Symbol, error) {
+	b, err := toSymbol(s)
+	if nil != err {
+		return b, err
+	}
 	switch b {
 	case plural.F:
 		culture.F = b
@@ -590,22 +732,17 @@ func setSymbol(s byte, culture *plural.Culture) plural.Symbol {
 		culture.T = b
 	case plural.W:
 		culture.W = b
+	case plural.E:
+		culture.E = b
 	case plural.P:
 		culture.P = b
 	}
-	return b
-}
-
-func getSymbolName(s plural.Symbol) string {
-	if s.Use() {
-		return toSymbol(byte(s)).Name()
-	}
-	return "_"
+	return b, nil
 }
 
 func toVarExpr(v plural.Var) string {
 	if v.Symbol == 'n' {
-		return fmt.Sprintf("mod(n, %d)", v.Mod)
+		return fmt.Sprintf("o.nmod(%d)", v.Mod)
 	}
 	return string(v.Symbol) + " % " + strconv.Itoa(v.Mod)
 }
@@ -621,7 +758,8 @@ func isRuleParsed(culture string, in []string, allPlurals, allOrdinals map[strin
 	return "", false
 }
 
-func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string]string) error {
+// selectCultures returns the cultures given by the -culture flag, sorted.
+func selectCultures(allPlurals map[string]map[string]string) ([]string, error) {
 	var cultures []string
 	if "*" == *user_culture {
 		for culture, _ := range allPlurals {
@@ -632,7 +770,7 @@ func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string
 			culture = strings.TrimSpace(culture)
 
 			if _, ok := allPlurals[culture]; !ok {
-				return fmt.Errorf("Aborted, `%s` not found...", culture)
+				return nil, fmt.Errorf("Aborted, `%s` not found...", culture)
 			}
 			cultures = append(cultures, culture)
 		}
@@ -640,14 +778,48 @@ func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string
 	sort.Strings(cultures)
 
 	if len(cultures) == 0 {
-		return fmt.Errorf("Not enough data to create source...")
+		return nil, fmt.Errorf("Not enough data to create source...")
+	}
+	return cultures, nil
+}
+
+// parsedCultures holds the parsed CLDR rules of the selected cultures, used
+// by both the generated files and the report.
+type parsedCultures struct {
+	items []Source
+	tests []Source
+
+	datas         []*plural.Culture
+	others        pie.Strings
+	ordinalOthers pie.Strings
+
+	// issues tells why cultures were left out.
+	issues Issues
+}
+
+// skipped returns why culture was left out, nil when generated.
+func (p *parsedCultures) skipped(culture string) *Issue {
+	for _, i := range p.issues {
+		if culture == i.Locale {
+			return i
+		}
 	}
+	return nil
+}
 
+func parseCultures(cultures []string, allPlurals, allOrdinals map[string]map[string]string) *parsedCultures {
 	var items []Source
 	var tests []Source
 
+	var issues Issues
+	skip := func(culture string, severity Severity, err error) {
+		log.Println(" \u2717 -", err)
+		issues = append(issues, &Issue{culture, severity, err})
+	}
+
 	datas := make([]*plural.Culture, 0, len(cultures))
 	others := make(pie.Strings, 0, len(cultures))
+	ordinalOthers := make(pie.Strings, 0, len(cultures))
 	culturesMap := make(map[language.Tag]*plural.Culture, len(cultures))
 	othersMap := make(map[language.Tag]bool, len(cultures))
 	for i, culture := range cultures {
@@ -666,28 +838,40 @@ func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string
 			if t.String() != culture {
 				others = others.Append(t.String())
 			}
+			if _, ok := allOrdinals[culture]; ok {
+				ordinalOthers = ordinalOthers.Append(culture)
+				if t.String() != culture {
+					ordinalOthers = ordinalOthers.Append(t.String())
+				}
+			}
 			continue
 		}
 
 		plurals, ok := allPlurals[culture]
 		if !ok {
-			log.Println(" \u2717 - Plural not defined")
+			skip(culture, Warning, fmt.Errorf("%w: no cardinal rules", ErrNotDefined))
 			continue
 		}
 
 		if _, ok = plurals["pluralRule-count-other"]; !ok {
-			log.Println(" \u2717 - Plural missing mandatory `other` choice...")
+			skip(culture, Warning, fmt.Errorf("%w: cardinal rules", ErrMissingOther))
 			continue
 		}
 
 		ordinals, ok := allOrdinals[culture]
 		if ok {
 			if _, ok = ordinals["pluralRule-count-other"]; !ok {
-				log.Println(" \u2717 - Ordinal missing the mandatory `other` choice...")
+				skip(culture, Warning, fmt.Errorf("%w: ordinal rules", ErrMissingOther))
 				continue
 			}
 		}
 
+		data, err := newCulture(culture, ordinals, plurals)
+		if nil != err {
+			skip(culture, Error, err)
+			continue
+		}
+
 		var dataAdded bool
 		if exist, ok := isRuleParsed(culture, cultures[:i], allPlurals, allOrdinals); ok {
 			if data, ok := culturesMap[language.MustParse(exist)]; ok {
@@ -701,18 +885,18 @@ func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string
 				if t.String() != culture {
 					others = others.Append(t.String())
 				}
+				if nil != ordinals {
+					ordinalOthers = ordinalOthers.Append(culture)
+					if t.String() != culture {
+						ordinalOthers = ordinalOthers.Append(t.String())
+					}
+				}
 				othersMap[t] = true
 			}
 			dataAdded = true
 		}
 
-		data := plural.Culture{
-			Langs:    []string{culture},
-			Cardinal: make(plural.Cases, 0, 5),
-			Ordinal:  make(plural.Cases, 0, 5),
-			Vars:     make([]plural.Var, 0, 8),
-		}
-		vars, code := culture2code(ordinals, plurals, "\t\t", &data)
+		vars, code := culture2code(&data, nil != ordinals)
 		if !dataAdded {
 			if data.HasCardinal() || data.HasOrdinal() {
 				datas = append(datas, &data)
@@ -725,6 +909,12 @@ func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string
 				if t.String() != culture {
 					others = others.Append(t.String())
 				}
+				if nil != ordinals {
+					ordinalOthers = ordinalOthers.Append(culture)
+					if t.String() != culture {
+						ordinalOthers = ordinalOthers.Append(t.String())
+					}
+				}
 				othersMap[t] = true
 			}
 		}
@@ -742,22 +932,61 @@ func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string
 		return lang == "und"
 	})
 
-	err := createPluralsData("plural/cultures.go", &culturesTplData{
+	return &parsedCultures{items, tests, datas, others, ordinalOthers, issues}
+}
+
+// createGoFiles writes the "plural" package, unless the issues met with the
+// CLDR rules fail the generation.
+func createGoFiles(headers string, allPlurals, allOrdinals map[string]map[string]string, locales *localeData) (Issues, error) {
+	cultures, err := selectCultures(allPlurals)
+	if nil != err {
+		return nil, err
+	}
+	locales = locales.filter(cultures)
+	parsed := parseCultures(cultures, allPlurals, allOrdinals)
+	if parsed.issues.Failed(*strict) {
+		return parsed.issues, nil
+	}
+	datas, others, tests := parsed.datas, parsed.others, parsed.tests
+
+	err = createPluralsData("plural/cultures.go", &culturesTplData{
 		Headers:  headers,
 		Cultures: datas,
 		Others:   []string(others),
+
+		OrdinalOthers: []string(parsed.ordinalOthers.Intersect(others).Sort()),
+		Parents:       locales.Parents,
+		LikelySubtags: locales.LikelySubtags,
+		Aliases:       locales.Aliases,
 	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	tables, err := buildTables(headers, datas, []string(others))
+	if err != nil {
+		return nil, err
+	}
+	err = createTables("plural/tables.go", tables)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(tests) > 0 {
-		err := createSource("plural_test.tmpl", "plural/func_test.go", headers, tests)
+		var benchmarks []Source
+		perClass := make(map[string]int)
+		for _, data := range datas {
+			source := NewBenchmarkSource(data)
+			if perClass[source.class]++; perClass[source.class] <= benchmarksPerClass {
+				benchmarks = append(benchmarks, source)
+			}
+		}
+		err := createSource("plural_test.tmpl", "plural/func_test.go", headers, tests, benchmarks)
 		if nil != err {
-			return err
+			return nil, err
 		}
 	}
-	return createSource("plural.tmpl", "plural/func.go", headers, items)
+	return parsed.issues, createSource("plural.tmpl", "plural/func.go", headers, parsed.items, nil)
 }
 
 const culturesTplStr = `// Generated by https://github.com/empirefox/makeplural
@@ -772,6 +1001,18 @@ var Info = PluralInfo{
 		{{- end }}
 	},
 	Others: {{ .Others | printf "%#v" }},
+	{{- if .OrdinalOthers }}
+	OrdinalOthers: {{ .OrdinalOthers | printf "%#v" }},
+	{{- end }}
+	{{- if .Parents }}
+	Parents: {{ .Parents | printf "%#v" }},
+	{{- end }}
+	{{- if .LikelySubtags }}
+	LikelySubtags: {{ .LikelySubtags | printf "%#v" }},
+	{{- end }}
+	{{- if .Aliases }}
+	Aliases: {{ .Aliases | printf "%#v" }},
+	{{- end }}
 }
 `
 
@@ -780,6 +1021,7 @@ const cultureTplStr = `{
 	{{ range . | symbols }} {{ if .Use }}{{.String}}:{{.String}},{{ end }} {{ end }}
 	{{ if .Cardinal }} Cardinal: {{ template "cases" .Cardinal }}, {{ end }}
 	{{ if .Ordinal }} Ordinal: {{ template "cases" .Ordinal }}, {{ end }}
+	{{ if .OrdinalData }} OrdinalData: true, {{ end }}
 	{{ if .Vars }} Vars: {{ template "vars" .Vars }}, {{ end }}
 	{{ if .Tests }} Tests: {{ template "tests" .Tests }}, {{ end }}
 }`
@@ -812,7 +1054,7 @@ const testCasesTplStr = `[]UnitTest{
 }`
 
 func symbolsTplFunc(c *plural.Culture) []plural.Symbol {
-	return []plural.Symbol{c.F, c.I, c.N, c.V, c.T, c.W, c.P}
+	return []plural.Symbol{c.F, c.I, c.N, c.V, c.T, c.W, c.E, c.P}
 }
 
 var culturesTpl = template.Must(template.New("cultures").
@@ -832,6 +1074,11 @@ type culturesTplData struct {
 	Headers  string
 	Cultures []*plural.Culture
 	Others   []string
+
+	OrdinalOthers []string
+	Parents       map[string]string
+	LikelySubtags map[string]string
+	Aliases       map[string]string
 }
 
 func createPluralsData(dest_filepath string, data *culturesTplData) error {
@@ -849,7 +1096,7 @@ func createPluralsData(dest_filepath string, data *culturesTplData) error {
 	return file.Save()
 }
 
-func createSource(tmpl_filepath, dest_filepath, headers string, items []Source) error {
+func createSource(tmpl_filepath, dest_filepath, headers string, items, benchmarks []Source) error {
 	source, err := template.ParseFiles(tmpl_filepath)
 	if nil != err {
 		return err
@@ -862,13 +1109,15 @@ func createSource(tmpl_filepath, dest_filepath, headers string, items []Source)
 	defer file.Close()
 
 	err = source.Execute(file, struct {
-		Headers   string
-		Timestamp string
-		Items     []Source
+		Headers    string
+		Timestamp  string
+		Items      []Source
+		Benchmarks []Source
 	}{
 		headers,
 		time.Now().Format(time.RFC3339),
 		items,
+		benchmarks,
 	})
 	if err != nil {
 		return err
@@ -907,35 +1156,115 @@ func (sf *sourceFile) Save() error {
 func (sf *sourceFile) Close() error { return sf.f.Close() }
 
 var user_culture = flag.String("culture", "*", "Culture subset")
+var strict = flag.Bool("strict", false, "Fail on warnings, such as locales left out")
+var cldr_dir = flag.String("cldr", "", "Local CLDR checkout whose LDML XML files are read instead of fetching cldr-json")
+
+// exitIssues is the exit status when the CLDR rules have errors, or warnings
+// with -strict; other failures exit with 1.
+const exitIssues = 2
 
 // TODO dont know howto really fix it
+// fixCLDR patches the known mistakes of the CLDR data.
+func fixCLDR(plurals map[string]map[string]string) {
+	if kw, ok := plurals["kw"]; ok {
+		kw["pluralRule-count-two"] = fixOrdinalsKwTwo
+	}
+}
+
 var fixOrdinalsKwTwo = "n % 100 = 2,22,42,62,82 @integer 2, 22, 42, 62, 82, 102, 122, 142, 1002, … @decimal 2.0, 22.0, 42.0, 62.0, 82.0, 102.0, 122.0, 142.0, 1002.0, …"
 
 func main() {
 	flag.Parse()
 
+	var report, export bool
+	var format string
+	switch flag.Arg(0) {
+	case "":
+	case "export":
+		export = true
+	case "report":
+		var err error
+		if format, err = parseReportFlags(flag.Args()[1:]); nil != err {
+			log.Fatalln(err)
+		}
+		report = true
+	case "diff":
+		if err := runDiff(os.Stdout, flag.Args()[1:]); nil != err {
+			log.Fatalln(err)
+		}
+		return
+	default:
+		log.Fatalf("Unknown command: `%s`", flag.Arg(0))
+	}
+
 	var headers string
 
-	ordinals, err := get("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json", "ordinal", &headers)
+	ordinals, err := getRules("ordinal", &headers)
 	if nil != err {
 		log.Println(" \u2717")
 		log.Fatalln(err)
 	}
 
 	log.Println(" \u2713")
-	plurals, err := get("https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json", "cardinal", &headers)
+	plurals, err := getRules("cardinal", &headers)
 	if nil != err {
 		log.Println(" \u2717")
 		log.Fatalln(err)
 	}
 
-	plurals["kw"]["pluralRule-count-two"] = fixOrdinalsKwTwo
+	fixCLDR(plurals)
 	log.Println(" \u2713")
 
-	err = createGoFiles(headers, plurals, ordinals)
+	var rangeIssues Issues
+	if "" != *cldr_dir {
+		ranges, err := getLDMLRanges(*cldr_dir)
+		if nil != err {
+			log.Println(" \u2717")
+			log.Fatalln(err)
+		}
+		if rangeIssues = checkRanges(ranges, plurals); 0 != len(rangeIssues) {
+			log.Println(" \u2717")
+		} else {
+			log.Println(" \u2713")
+		}
+	}
+
+	if report {
+		if err = runReport(os.Stdout, format, plurals, ordinals); nil != err {
+			log.Fatalln(err)
+		}
+		return
+	}
+
+	var issues Issues
+	switch {
+	case rangeIssues.Failed(*strict):
+		// nothing is generated, the plural ranges being listed below
+	case export:
+		issues, err = runExport(os.Stdout, plurals, ordinals)
+	default:
+		var locales *localeData
+		if "" != *cldr_dir {
+			locales, err = getLDMLLocales(*cldr_dir, &headers)
+		} else {
+			locales, err = getLocales(&headers)
+		}
+		if nil != err {
+			log.Println(" \u2717")
+			log.Fatalln(err)
+		}
+		log.Println(" \u2713")
+		issues, err = createGoFiles(headers, plurals, ordinals, locales)
+	}
 	if nil != err {
 		log.Fatalln(err, "(╯°□°）╯︵ ┻━┻")
 	}
+	issues = append(rangeIssues, issues...)
 
+	issues.Summary()
+	if issues.Failed(*strict) {
+		log.Println("Aborted, nothing written (╯°□°）╯︵ ┻━┻")
+		os.Exit(exitIssues)
+	}
 	log.Println("Succeed (ッ)")
 }
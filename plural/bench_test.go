@@ -0,0 +1,56 @@
+package plural
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// The benchmarks run against the generated closures by default and against
+// the compact rule tables with -tags plural_compact, compare both with
+// benchstat.
+
+var benchValues = []interface{}{0, 1, 2, 5, 11, 21, 101, 1000000, 1.5, "0.0", "1.25", "21.0"}
+
+func benchmarkFunc(b *testing.B, culture string) {
+	fn, err := GetFunc(language.MustParse(culture))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		value := benchValues[i%len(benchValues)]
+		fn(value, false)
+		fn(value, true)
+	}
+}
+
+// ja only has "other".
+func BenchmarkFunc_ja(b *testing.B) { benchmarkFunc(b, "ja") }
+
+// en needs i and v.
+func BenchmarkFunc_en(b *testing.B) { benchmarkFunc(b, "en") }
+
+// fr only needs n and i.
+func BenchmarkFunc_fr(b *testing.B) { benchmarkFunc(b, "fr") }
+
+// ru needs mod variables.
+func BenchmarkFunc_ru(b *testing.B) { benchmarkFunc(b, "ru") }
+
+// ar has ranges on n % 100.
+func BenchmarkFunc_ar(b *testing.B) { benchmarkFunc(b, "ar") }
+
+// lv needs f, v and their mod variables.
+func BenchmarkFunc_lv(b *testing.B) { benchmarkFunc(b, "lv") }
+
+func BenchmarkGetFunc(b *testing.B) {
+	tags := []language.Tag{language.English, language.Russian, language.Arabic, language.Japanese}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := GetFunc(tags[i%len(tags)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
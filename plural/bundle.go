@@ -0,0 +1,270 @@
+package plural
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	// ErrNotDefined is returned for a language of a bundle having ordinal
+	// rules but no cardinal rules.
+	ErrNotDefined = errors.New("NotDefined")
+	// ErrMissingOther is returned for rules lacking the mandatory "other".
+	ErrMissingOther = errors.New("MissingOther")
+)
+
+// Bundle holds the CLDR rules of several cultures, keyed by language then by
+// category as in plurals.json and ordinals.json:
+//
+//	Cardinal["ru"]["pluralRule-count-one"] = "v = 0 and i % 10 = 1 and i % 100 != 11"
+//
+// Languages without ordinal rules have their ordinals Other. Encoded to
+// JSON, it is the bundle written by make-plural export.
+type Bundle struct {
+	Cardinal map[string]map[string]string `json:"cardinal"`
+	Ordinal  map[string]map[string]string `json:"ordinal,omitempty"`
+}
+
+// ReadBundle reads a bundle from r, either a CLDR supplemental document,
+// plurals.json or ordinals.json, or a bundle encoded to JSON.
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	var document struct {
+		Supplemental map[string]json.RawMessage `json:"supplemental"`
+		Bundle
+	}
+	if err := json.NewDecoder(r).Decode(&document); nil != err {
+		return nil, err
+	}
+	b := &document.Bundle
+	if nil != document.Supplemental {
+		b = &Bundle{}
+	}
+	for key, dest := range map[string]*map[string]map[string]string{
+		"plurals-type-cardinal": &b.Cardinal,
+		"plurals-type-ordinal":  &b.Ordinal,
+	} {
+		if data, ok := document.Supplemental[key]; ok {
+			if err := json.Unmarshal(data, dest); nil != err {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+	if nil == b.Cardinal && nil == b.Ordinal {
+		return nil, fmt.Errorf("no plural rules")
+	}
+	return b, nil
+}
+
+// LoadBundle reads the bundle of the files at paths, as ReadBundle does,
+// such as plurals.json and ordinals.json. The rules of a language in a file
+// replace those of the previous files.
+func LoadBundle(paths ...string) (*Bundle, error) {
+	b := &Bundle{}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if nil != err {
+			return nil, err
+		}
+		read, err := ReadBundle(f)
+		f.Close()
+		if nil != err {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		b.merge(read)
+	}
+	return b, nil
+}
+
+func (b *Bundle) merge(from *Bundle) {
+	for _, m := range []struct{ dest, src *map[string]map[string]string }{
+		{&b.Cardinal, &from.Cardinal},
+		{&b.Ordinal, &from.Ordinal},
+	} {
+		for lang, rules := range *m.src {
+			if nil == *m.dest {
+				*m.dest = make(map[string]map[string]string, len(*m.src))
+			}
+			(*m.dest)[lang] = rules
+		}
+	}
+}
+
+// Validate checks the rules of b as make-plural does: every language has
+// cardinal rules, the rules parse and include "other". The error of the
+// first language failing, in alphabetical order, is returned.
+func (b *Bundle) Validate() error {
+	_, err := b.compile()
+	return err
+}
+
+func (b *Bundle) compile() (map[language.Tag]*registered, error) {
+	langs := make([]string, 0, len(b.Cardinal)+len(b.Ordinal))
+	for lang := range b.Cardinal {
+		langs = append(langs, lang)
+	}
+	for lang := range b.Ordinal {
+		if _, ok := b.Cardinal[lang]; !ok {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs)
+
+	base := make(map[language.Tag]*registered, len(langs))
+	for _, lang := range langs {
+		tag, err := language.Parse(lang)
+		if nil != err {
+			return nil, fmt.Errorf("UnknownCulture: `%s`", lang)
+		}
+		cardinal, ok := b.Cardinal[lang]
+		if !ok {
+			return nil, fmt.Errorf("%w: `%s` has no cardinal rules", ErrNotDefined, lang)
+		}
+		ordinal := b.Ordinal[lang]
+		if !hasOther(cardinal) {
+			return nil, fmt.Errorf("%w: `%s` cardinal rules", ErrMissingOther, lang)
+		}
+		if nil != ordinal && !hasOther(ordinal) {
+			return nil, fmt.Errorf("%w: `%s` ordinal rules", ErrMissingOther, lang)
+		}
+
+		fn, err := parseRegistered(cardinal, ordinal)
+		if nil != err {
+			return nil, fmt.Errorf("`%s` %w", lang, err)
+		}
+		base[tag] = fn
+	}
+	return base, nil
+}
+
+func hasOther(rules map[string]string) bool {
+	for key := range rules {
+		if "other" == strings.TrimPrefix(key, "pluralRule-count-") {
+			return true
+		}
+	}
+	return false
+}
+
+// NewBundleRegistry returns a registry of the rules of b, validated as
+// Validate does.
+func NewBundleRegistry(b *Bundle) (*Registry, error) {
+	base, err := b.compile()
+	if nil != err {
+		return nil, err
+	}
+	return &Registry{info: &Info, base: base}, nil
+}
+
+// Load replaces the rules r was built with by those of b, validated as
+// Validate does, keeping the rules registered at runtime. Lookups see either
+// the previous rules or the new ones, the functions returned before keep
+// evaluating the previous rules.
+func (r *Registry) Load(b *Bundle) error {
+	base, err := b.compile()
+	if nil != err {
+		return err
+	}
+	// the generated rules are not needed anymore
+	r.once.Do(func() {})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.base = base
+	return nil
+}
+
+// LoadFiles loads the bundle of the files at paths into r, as LoadBundle
+// reads them.
+func (r *Registry) LoadFiles(paths ...string) error {
+	b, err := LoadBundle(paths...)
+	if nil != err {
+		return err
+	}
+	return r.Load(b)
+}
+
+// Watch loads the bundle of the files at paths into r, as LoadFiles does,
+// then checks the files every interval until ctx is done, loading them again
+// when one changes. onReload, which may be nil, is told of every reload:
+// the rules stay in place when the files fail to load. Files are better
+// replaced by a rename than written in place, which may be seen half way.
+func (r *Registry) Watch(ctx context.Context, interval time.Duration, onReload func(error), paths ...string) error {
+	stamps, err := statFiles(paths)
+	if nil != err {
+		return err
+	}
+	if err := r.LoadFiles(paths...); nil != err {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current, err := statFiles(paths)
+			if nil != err {
+				// reported once, until the files are back
+				if nil != stamps && nil != onReload {
+					onReload(err)
+				}
+				stamps = nil
+				continue
+			}
+			if equalStamps(current, stamps) {
+				continue
+			}
+			stamps = current
+
+			err = r.LoadFiles(paths...)
+			if nil != onReload {
+				onReload(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// fileStamp tells whether a file changed.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFiles(paths []string) ([]fileStamp, error) {
+	stamps := make([]fileStamp, len(paths))
+	for i, path := range paths {
+		fi, err := os.Stat(path)
+		if nil != err {
+			return nil, err
+		}
+		stamps[i] = fileStamp{fi.ModTime(), fi.Size()}
+	}
+	return stamps, nil
+}
+
+func equalStamps(a, b []fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+	return true
+}
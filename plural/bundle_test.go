@@ -0,0 +1,222 @@
+package plural
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	testPluralsJSON = `{"supplemental": {
+		"version": {"_cldrVersion": "36"},
+		"plurals-type-cardinal": {
+			"fr": {
+				"pluralRule-count-one": "i = 0,1 @integer 0, 1",
+				"pluralRule-count-other": " @integer 2~17, 100"
+			},
+			"ja": {"pluralRule-count-other": " @integer 0~15, 100"}
+		}
+	}}`
+	testOrdinalsJSON = `{"supplemental": {
+		"version": {"_cldrVersion": "36"},
+		"plurals-type-ordinal": {
+			"fr": {
+				"pluralRule-count-one": "n = 1 @integer 1",
+				"pluralRule-count-other": " @integer 0, 2~16, 100"
+			}
+		}
+	}}`
+)
+
+func writeFile(t *testing.T, path, contents string) {
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+}
+
+// replaceFile changes the file at path as a whole, modified at the given
+// time, so a watcher polling it never reads a partial write.
+func replaceFile(t *testing.T, path, contents string, modified time.Time) {
+	tmp := path + ".tmp"
+	writeFile(t, tmp, contents)
+	if err := os.Chtimes(tmp, modified, modified); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if err := os.Rename(tmp, path); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+}
+
+func TestLoadBundle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plural")
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	plurals, ordinals := filepath.Join(dir, "plurals.json"), filepath.Join(dir, "ordinals.json")
+	writeFile(t, plurals, testPluralsJSON)
+	writeFile(t, ordinals, testOrdinalsJSON)
+
+	b, err := LoadBundle(plurals, ordinals)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if 2 != len(b.Cardinal) || 1 != len(b.Ordinal) || "n = 1 @integer 1" != b.Ordinal["fr"]["pluralRule-count-one"] {
+		t.Errorf("Unexpected bundle %v", b)
+	}
+	if err := b.Validate(); nil != err {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+
+	// the export of make-plural
+	export, _ := json.Marshal(b)
+	read, err := ReadBundle(strings.NewReader(string(export)))
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	r, err := NewBundleRegistry(read)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	fn, _ := r.GetFunc(language.French)
+	if result := fn(1, true); "one" != result {
+		t.Errorf("`fr` fn(1, true) expecting <one> but got <%s>", result)
+	}
+
+	for _, contents := range []string{`{}`, `{"supplemental": {"version": {}}}`, `[`} {
+		if _, err := ReadBundle(strings.NewReader(contents)); nil == err {
+			t.Errorf("`%s` expecting an error", contents)
+		}
+	}
+}
+
+// TestLoadBundleCLDR loads the plurals.json of a recent CLDR, whose rules
+// use the e operand, and checks the samples of each rule, ranges by their
+// bounds.
+func TestLoadBundleCLDR(t *testing.T) {
+	b, err := LoadBundle(filepath.Join("testdata", "plurals.json"))
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	r, err := NewBundleRegistry(b)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	for lang, rules := range b.Cardinal {
+		tag, err := language.Parse(lang)
+		if nil != err {
+			continue
+		}
+		fn, err := r.GetFunc(tag)
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", lang, err.Error())
+			continue
+		}
+		for key, rule := range rules {
+			form := strings.TrimPrefix(key, "pluralRule-count-")
+			idx := strings.IndexByte(rule, '@')
+			if idx < 0 {
+				continue
+			}
+			for _, samples := range strings.Split(rule[idx+1:], "@") {
+				samples = strings.TrimPrefix(strings.TrimPrefix(samples, "integer"), "decimal")
+				for _, sample := range strings.Split(samples, ",") {
+					for _, value := range strings.Split(strings.TrimSpace(sample), "~") {
+						if "…" == value || "" == value {
+							continue
+						}
+						if result := fn(value, false); result != form {
+							t.Errorf("`%s` %s expecting <%s> but got <%s>", lang, value, form, result)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	fn, _ := r.GetFunc(language.French)
+	for value, expected := range map[string]string{"1": "one", "2000": "other", "1000000": "many", "1.2c6": "many"} {
+		if result := fn(value, false); result != expected {
+			t.Errorf("`fr` %s expecting <%s> but got <%s>", value, expected, result)
+		}
+	}
+}
+
+func TestBundleValidate(t *testing.T) {
+	tests := []struct {
+		bundle   Bundle
+		expected error
+	}{
+		{Bundle{Cardinal: map[string]map[string]string{"fr": {"one": "i = 0,1"}}}, ErrMissingOther},
+		{Bundle{
+			Cardinal: map[string]map[string]string{"fr": {"other": ""}},
+			Ordinal:  map[string]map[string]string{"fr": {"one": "n = 1"}},
+		}, ErrMissingOther},
+		{Bundle{Ordinal: map[string]map[string]string{"fr": {"other": ""}}}, ErrNotDefined},
+		{Bundle{Cardinal: map[string]map[string]string{"fr": {"one": "x = 0", "other": ""}}}, ErrInvalidRule},
+	}
+	for _, test := range tests {
+		if err := test.bundle.Validate(); !errors.Is(err, test.expected) {
+			t.Errorf("%v expecting %v but got %v", test.bundle, test.expected, err)
+		}
+	}
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plural")
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "plurals.json")
+	writeFile(t, path, testPluralsJSON)
+
+	r, err := NewRegistry(&Info)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloads := make(chan error, 10)
+	if err := r.Watch(ctx, 5*time.Millisecond, func(err error) { reloads <- err }, path); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if _, err := r.GetFunc(language.Russian); nil == err {
+		t.Errorf("`ru` expecting an error once the bundle loaded")
+	}
+	fn, _ := r.GetFunc(language.French)
+	if result := fn(1, false); "one" != result {
+		t.Errorf("`fr` fn(1, false) expecting <one> but got <%s>", result)
+	}
+
+	// a change whose rules do not parse leaves them in place
+	modified := time.Now().Add(time.Minute)
+	replaceFile(t, path, strings.Replace(testPluralsJSON, "i = 0,1", "i = 0,", 1), modified)
+	if err := <-reloads; !errors.Is(err, ErrInvalidRule) {
+		t.Errorf("expecting ErrInvalidRule but got %v", err)
+	}
+	fn, _ = r.GetFunc(language.French)
+	if result := fn(1, false); "one" != result {
+		t.Errorf("`fr` fn(1, false) expecting <one> but got <%s>", result)
+	}
+
+	modified = modified.Add(time.Minute)
+	replaceFile(t, path, strings.Replace(testPluralsJSON, "i = 0,1", "i = 0", 1), modified)
+	if err := <-reloads; nil != err {
+		t.Errorf("Unexpected error: %s", err.Error())
+	}
+	fn, _ = r.GetFunc(language.French)
+	if result := fn(1, false); "other" != result {
+		t.Errorf("`fr` fn(1, false) expecting <other> but got <%s>", result)
+	}
+}
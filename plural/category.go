@@ -0,0 +1,62 @@
+package plural
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Category is a plural category, Other being the zero value.
+type Category uint8
+
+const (
+	Other Category = iota
+	Zero
+	One
+	Two
+	Few
+	Many
+)
+
+var categoryNames = [...]string{
+	Other: "other",
+	Zero:  "zero",
+	One:   "one",
+	Two:   "two",
+	Few:   "few",
+	Many:  "many",
+}
+
+// ParseCategory returns the category named as in CLDR, "one" for instance.
+func ParseCategory(name string) (Category, error) {
+	for c, n := range categoryNames {
+		if n == name {
+			return Category(c), nil
+		}
+	}
+	return Other, fmt.Errorf("UnknownCategory: `%s`", name)
+}
+
+func (c Category) String() string {
+	if int(c) < len(categoryNames) {
+		return categoryNames[c]
+	}
+	return "Category(" + strconv.Itoa(int(c)) + ")"
+}
+
+// MarshalText returns the CLDR name of c.
+func (c Category) MarshalText() ([]byte, error) {
+	if int(c) >= len(categoryNames) {
+		return nil, fmt.Errorf("UnknownCategory: `%s`", c)
+	}
+	return []byte(categoryNames[c]), nil
+}
+
+// UnmarshalText sets c from its CLDR name.
+func (c *Category) UnmarshalText(text []byte) error {
+	category, err := ParseCategory(string(text))
+	if nil != err {
+		return err
+	}
+	*c = category
+	return nil
+}
@@ -0,0 +1,40 @@
+package plural
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCategoryText(t *testing.T) {
+	for _, c := range []Category{Other, Zero, One, Two, Few, Many} {
+		text, err := c.MarshalText()
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", c, err.Error())
+			continue
+		}
+
+		var parsed Category
+		if err := parsed.UnmarshalText(text); nil != err || parsed != c {
+			t.Errorf("`%s` expecting %v but got %v (%v)", text, c, parsed, err)
+		}
+	}
+
+	if _, err := Category(42).MarshalText(); nil == err {
+		t.Errorf("`Category(42)` expecting an error")
+	}
+	if _, err := ParseCategory("One"); nil == err {
+		t.Errorf("`One` expecting an error")
+	}
+}
+
+func TestCategoryJSON(t *testing.T) {
+	b, err := json.Marshal(map[string]Category{"n": Few})
+	if nil != err || `{"n":"few"}` != string(b) {
+		t.Errorf("expecting {\"n\":\"few\"} but got %s (%v)", b, err)
+	}
+
+	var m map[string]Category
+	if err := json.Unmarshal([]byte(`{"n":"many"}`), &m); nil != err || Many != m["n"] {
+		t.Errorf("expecting many but got %v (%v)", m["n"], err)
+	}
+}
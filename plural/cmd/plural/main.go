@@ -0,0 +1,164 @@
+// Command plural prints the plural category of numbers in given locales,
+// with their operands and the CLDR rule that matched, as plural.Explain
+// does:
+//
+//	plural ru 21 1.5
+//	plural -ordinal en,fr 1 2 3
+//	seq 1 30 | plural -json ru
+//
+// Without numbers in arguments, numbers are read from stdin, separated by
+// spaces or lines. With -json, a JSON object is printed per line.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// Result is the category of a number in a locale.
+type Result struct {
+	Locale string `json:"locale"`
+	// Resolved is the locale whose rules were used.
+	Resolved string          `json:"resolved"`
+	Number   string          `json:"number"`
+	Ordinal  bool            `json:"ordinal"`
+	Category plural.Category `json:"category"`
+	Operands Operands        `json:"operands"`
+	// Rule is the condition of Category in plural.Info, empty for "other".
+	Rule string `json:"rule,omitempty"`
+}
+
+// Operands are the plural.Operands with JSON names.
+type Operands struct {
+	N float64 `json:"n"`
+	I int64   `json:"i"`
+	V int     `json:"v"`
+	W int     `json:"w"`
+	F int64   `json:"f"`
+	T int64   `json:"t"`
+	E int     `json:"e"`
+}
+
+func (r Result) String() string {
+	kind := "cardinal"
+	if r.Ordinal {
+		kind = "ordinal"
+	}
+	o := r.Operands
+	s := fmt.Sprintf("%s %s %s: %s (n=%v i=%d v=%d w=%d f=%d t=%d",
+		r.Locale, r.Number, kind, r.Category, o.N, o.I, o.V, o.W, o.F, o.T)
+	if 0 != o.E {
+		s += fmt.Sprintf(" e=%d", o.E)
+	}
+	s += ")"
+	if r.Resolved != r.Locale {
+		s += " using " + r.Resolved
+	}
+	if "" != r.Rule {
+		s += " rule: " + r.Rule
+	}
+	return s
+}
+
+func eval(tag language.Tag, name, number string, ordinal bool) (Result, error) {
+	o, err := plural.ParseOperands(number)
+	if nil != err {
+		return Result{}, fmt.Errorf("`%s`: %s", number, err)
+	}
+	e, err := plural.Explain(tag, o, ordinal)
+	if nil != err {
+		return Result{}, err
+	}
+
+	r := Result{
+		Locale:   name,
+		Resolved: e.Resolved.String(),
+		Number:   number,
+		Ordinal:  ordinal,
+		Category: e.Category,
+		Operands: Operands{o.N, o.I, o.V, o.W, o.F, o.T, o.E},
+	}
+	if -1 != e.Matched {
+		r.Rule = e.Cases[e.Matched].Cond
+	}
+	return r, nil
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("plural", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	ordinal := fs.Bool("ordinal", false, "Use the ordinal rules")
+	asJSON := fs.Bool("json", false, "Print a JSON object per line")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: plural [-ordinal] [-json] LOCALE[,LOCALE...] [NUMBER...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); nil != err {
+		return 2
+	}
+	if fs.NArg() < 1 {
+		fs.Usage()
+		return 2
+	}
+
+	names := strings.Split(fs.Arg(0), ",")
+	tags := make([]language.Tag, len(names))
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+		tag, err := language.Parse(names[i])
+		if nil != err {
+			fmt.Fprintf(stderr, "`%s`: %s\n", names[i], err)
+			return 1
+		}
+		tags[i] = tag
+	}
+
+	status := 0
+	e := json.NewEncoder(stdout)
+	print := func(number string) {
+		for i, tag := range tags {
+			r, err := eval(tag, names[i], number, *ordinal)
+			if nil != err {
+				fmt.Fprintln(stderr, err)
+				status = 1
+				return
+			}
+			if *asJSON {
+				e.Encode(r)
+			} else {
+				fmt.Fprintln(stdout, r)
+			}
+		}
+	}
+
+	if fs.NArg() > 1 {
+		for _, number := range fs.Args()[1:] {
+			print(number)
+		}
+		return status
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		print(scanner.Text())
+	}
+	if err := scanner.Err(); nil != err {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return status
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"ru", "21", "1.5", "1.5c3"}, nil, &stdout, &stderr); 0 != status {
+		t.Fatalf("expecting status 0 but got %d: %s", status, stderr.String())
+	}
+	expected := "ru 21 cardinal: one (n=21 i=21 v=0 w=0 f=0 t=0) rule: v == 0 && i10 == 1 && i100 != 11\n" +
+		"ru 1.5 cardinal: other (n=1.5 i=1 v=1 w=1 f=5 t=5)\n" +
+		"ru 1.5c3 cardinal: many (n=1500 i=1500 v=0 w=0 f=0 t=0 e=3) rule: v == 0 && i10 == 0 || v == 0 && i10 >= 5 && i10 <= 9 || v == 0 && i100 >= 11 && i100 <= 14\n"
+	if result := stdout.String(); result != expected {
+		t.Errorf("expecting\n%s\nbut got\n%s", expected, result)
+	}
+}
+
+func TestRunStdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"-json", "-ordinal", "en,fr"}, strings.NewReader("1 x\n2.0\n"), &stdout, &stderr)
+	if 1 != status {
+		t.Errorf("expecting status 1 but got %d", status)
+	}
+	if !strings.Contains(stderr.String(), "`x`") {
+		t.Errorf("expecting an error about `x` but got %q", stderr.String())
+	}
+
+	var results []Result
+	d := json.NewDecoder(&stdout)
+	for d.More() {
+		var r Result
+		if err := d.Decode(&r); nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		results = append(results, r)
+	}
+
+	expected := []struct {
+		locale, number string
+		category       plural.Category
+	}{
+		{"en", "1", plural.One},
+		{"fr", "1", plural.One},
+		{"en", "2.0", plural.Two},
+		{"fr", "2.0", plural.Other},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expecting %d results but got %+v", len(expected), results)
+	}
+	for i, e := range expected {
+		r := results[i]
+		if r.Locale != e.locale || r.Number != e.number || r.Category != e.category || !r.Ordinal {
+			t.Errorf("expecting %s %s <%s> but got %+v", e.locale, e.number, e.category, r)
+		}
+	}
+}
+
+func TestRunLocales(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"e!", "1"}, nil, &stdout, &stderr); 1 != status {
+		t.Errorf("expecting status 1 but got %d", status)
+	}
+
+	stdout.Reset()
+	if status := run([]string{"en-GB", "1"}, nil, &stdout, &stderr); 0 != status {
+		t.Errorf("expecting status 0 but got %d", status)
+	}
+	if !strings.Contains(stdout.String(), "using en") {
+		t.Errorf("expecting en-GB using en but got %q", stdout.String())
+	}
+}
@@ -0,0 +1,303 @@
+// Command pluralserver serves the plural rules of plural.Info as JSON over
+// HTTP, for services not written in Go:
+//
+//	pluralserver -addr :8080
+//	curl 'localhost:8080/plural?locale=ru&n=21&ordinal=false'
+//	curl -d '[{"locale":"ru","n":"1.5"},{"locale":"en","n":2,"ordinal":true}]' localhost:8080/plural
+//	curl localhost:8080/locales
+//	curl localhost:8080/rules/pt-PT
+//
+// GET /openapi.json describes the endpoints.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+const (
+	// maxBatch is the number of numbers a POST /plural may hold.
+	maxBatch = 1000
+	// maxBody is the size of a POST /plural body.
+	maxBody = 1 << 20
+)
+
+var (
+	errUnknownCulture = errors.New("UnknownCulture")
+	errInvalidRequest = errors.New("InvalidRequest")
+)
+
+// Request is a number whose category is asked in a locale.
+type Request struct {
+	Locale  string `json:"locale"`
+	N       Number `json:"n"`
+	Ordinal bool   `json:"ordinal"`
+}
+
+// Number is a JSON number or a decimal string, kept as written: "1.50" keeps
+// its visible fraction digits.
+type Number string
+
+func (n *Number) UnmarshalJSON(b []byte) error {
+	if 0 != len(b) && '"' == b[0] {
+		return json.Unmarshal(b, (*string)(n))
+	}
+	var number json.Number
+	if err := json.Unmarshal(b, &number); nil != err {
+		return err
+	}
+	*n = Number(number)
+	return nil
+}
+
+// Result is the category of a number in a locale, or the error it got in a
+// batch.
+type Result struct {
+	Locale string `json:"locale"`
+	// Alias is the canonical locale of a deprecated or legacy code, "he"
+	// for "iw".
+	Alias string `json:"alias,omitempty"`
+	// Resolved is the locale whose rules were used, "en" for "en-GB".
+	Resolved string `json:"resolved,omitempty"`
+	Number   string `json:"n"`
+	Ordinal  bool   `json:"ordinal"`
+	Category string `json:"category,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Locale tells the categories of a locale.
+type Locale struct {
+	Locale     string   `json:"locale"`
+	Cardinal   []string `json:"cardinal"`
+	Ordinal    []string `json:"ordinal"`
+	HasOrdinal bool     `json:"hasOrdinal"`
+}
+
+// Case is a plural.Case with JSON names.
+type Case struct {
+	Form string `json:"form"`
+	Cond string `json:"cond"`
+}
+
+// UnitTest is a plural.UnitTest with JSON names.
+type UnitTest struct {
+	Expected string   `json:"expected"`
+	Integers []string `json:"integers,omitempty"`
+	Decimals []string `json:"decimals,omitempty"`
+}
+
+// Rules is the plural.Culture data of a locale. The conditions are written
+// as the generated Go functions, such as "n10 == 1 && n100 != 11", "other"
+// applying when none matches.
+type Rules struct {
+	Locale   string `json:"locale"`
+	Alias    string `json:"alias,omitempty"`
+	Resolved string `json:"resolved"`
+	// Langs are the locales sharing the rules.
+	Langs      []string `json:"langs"`
+	Cardinal   []Case   `json:"cardinal"`
+	Ordinal    []Case   `json:"ordinal"`
+	HasOrdinal bool     `json:"hasOrdinal"`
+	Tests      struct {
+		Cardinal []UnitTest `json:"cardinal"`
+		Ordinal  []UnitTest `json:"ordinal"`
+	} `json:"tests"`
+}
+
+// find returns the culture of a locale, nil for the Others, and the
+// canonical locale when it is an alias.
+func find(locale string) (*plural.Culture, language.Tag, string, error) {
+	// parsed raw, so that the aliases applied are told
+	tag, err := language.Raw.Parse(locale)
+	if nil != err {
+		return nil, tag, "", fmt.Errorf("%w: `%s`: %s", errInvalidRequest, locale, err)
+	}
+	var alias string
+	if canonical, ok := plural.Info.Canonicalize(tag); ok {
+		alias = canonical.String()
+	}
+	c, on, found := plural.Info.Find(tag)
+	if !found {
+		return nil, tag, alias, fmt.Errorf("%w: `%s`", errUnknownCulture, locale)
+	}
+	return c, on, alias, nil
+}
+
+func eval(req Request) (Result, error) {
+	r := Result{Locale: req.Locale, Number: string(req.N), Ordinal: req.Ordinal}
+	_, on, alias, err := find(req.Locale)
+	if nil != err {
+		return r, err
+	}
+	fn, err := plural.GetFunc(on)
+	if nil != err {
+		return r, fmt.Errorf("%w: `%s`", errUnknownCulture, req.Locale)
+	}
+	if _, err := plural.ParseOperands(r.Number); nil != err {
+		return r, fmt.Errorf("%w: `%s`: %s", errInvalidRequest, r.Number, err)
+	}
+
+	r.Alias, r.Resolved = alias, on.String()
+	r.Category = fn(r.Number, req.Ordinal)
+	return r, nil
+}
+
+func categories(cases plural.Cases) []string {
+	forms := make([]string, 0, len(cases)+1)
+	for _, c := range cases {
+		forms = append(forms, c.Form)
+	}
+	return append(forms, plural.Other.String())
+}
+
+func newRules(locale, alias string, c *plural.Culture, on language.Tag) *Rules {
+	rules := &Rules{
+		Locale:     locale,
+		Alias:      alias,
+		Resolved:   on.String(),
+		Langs:      []string{on.String()},
+		Cardinal:   []Case{},
+		Ordinal:    []Case{},
+		HasOrdinal: plural.Info.HasOrdinal(on),
+	}
+	rules.Tests.Cardinal, rules.Tests.Ordinal = []UnitTest{}, []UnitTest{}
+	if nil == c {
+		return rules
+	}
+
+	rules.Langs = c.Langs
+	for _, x := range c.Cardinal {
+		rules.Cardinal = append(rules.Cardinal, Case{x.Form, x.Cond})
+	}
+	for _, x := range c.Ordinal {
+		rules.Ordinal = append(rules.Ordinal, Case{x.Form, x.Cond})
+	}
+	for _, t := range c.Tests.Cardinal {
+		rules.Tests.Cardinal = append(rules.Tests.Cardinal, UnitTest{t.Expected, t.Integers, t.Decimals})
+	}
+	for _, t := range c.Tests.Ordinal {
+		rules.Tests.Ordinal = append(rules.Tests.Ordinal, UnitTest{t.Expected, t.Integers, t.Decimals})
+	}
+	return rules
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, errInvalidRequest):
+		status = http.StatusBadRequest
+	case errors.Is(err, errUnknownCulture):
+		status = http.StatusNotFound
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func handlePlural(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req := Request{Locale: q.Get("locale"), N: Number(q.Get("n"))}
+		if s := q.Get("ordinal"); "" != s {
+			var err error
+			if req.Ordinal, err = strconv.ParseBool(s); nil != err {
+				writeError(w, fmt.Errorf("%w: ordinal `%s`", errInvalidRequest, s))
+				return
+			}
+		}
+		result, err := eval(req)
+		if nil != err {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+
+	case http.MethodPost:
+		var reqs []Request
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBody)).Decode(&reqs); nil != err {
+			writeError(w, fmt.Errorf("%w: %s", errInvalidRequest, err))
+			return
+		}
+		if len(reqs) > maxBatch {
+			writeError(w, fmt.Errorf("%w: more than %d numbers", errInvalidRequest, maxBatch))
+			return
+		}
+
+		results := make([]Result, len(reqs))
+		for i, req := range reqs {
+			var err error
+			if results[i], err = eval(req); nil != err {
+				results[i].Error = err.Error()
+			}
+		}
+		writeJSON(w, http.StatusOK, results)
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "MethodNotAllowed"})
+	}
+}
+
+func handleLocales(w http.ResponseWriter, r *http.Request) {
+	langs := plural.Info.Langs()
+	locales := make([]Locale, 0, len(langs))
+	for _, lang := range langs {
+		c, on, _, err := find(lang)
+		if nil != err {
+			continue
+		}
+		l := Locale{Locale: lang, Cardinal: []string{"other"}, Ordinal: []string{"other"}, HasOrdinal: plural.Info.HasOrdinal(on)}
+		if nil != c {
+			l.Cardinal, l.Ordinal = categories(c.Cardinal), categories(c.Ordinal)
+		}
+		locales = append(locales, l)
+	}
+	writeJSON(w, http.StatusOK, locales)
+}
+
+func handleRules(w http.ResponseWriter, r *http.Request) {
+	locale := strings.TrimPrefix(r.URL.Path, "/rules/")
+	c, on, alias, err := find(locale)
+	if nil != err {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newRules(locale, alias, c, on))
+}
+
+func handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(openAPI))
+}
+
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/plural", handlePlural)
+	mux.HandleFunc("/locales", handleLocales)
+	mux.HandleFunc("/rules/", handleRules)
+	mux.HandleFunc("/openapi.json", handleOpenAPI)
+	return mux
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "Address to listen on")
+	flag.Parse()
+
+	log.Println("Listening on", *addr)
+	log.Fatalln(http.ListenAndServe(*addr, newHandler()))
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func do(t *testing.T, method, target, body string, v interface{}) int {
+	var r *http.Request
+	if "" == body {
+		r = httptest.NewRequest(method, target, nil)
+	} else {
+		r = httptest.NewRequest(method, target, strings.NewReader(body))
+	}
+	w := httptest.NewRecorder()
+	newHandler().ServeHTTP(w, r)
+
+	if "application/json" != w.Header().Get("Content-Type") {
+		t.Errorf("%s %s expecting JSON but got %s", method, target, w.Header().Get("Content-Type"))
+	}
+	if nil != v {
+		if err := json.Unmarshal(w.Body.Bytes(), v); nil != err {
+			t.Fatalf("%s %s: %s", method, target, err.Error())
+		}
+	}
+	return w.Code
+}
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		target   string
+		status   int
+		expected Result
+	}{
+		{"/plural?locale=ru&n=21", http.StatusOK, Result{Locale: "ru", Resolved: "ru", Number: "21", Category: "one"}},
+		{"/plural?locale=ru&n=1.5", http.StatusOK, Result{Locale: "ru", Resolved: "ru", Number: "1.5", Category: "other"}},
+		{"/plural?locale=en-GB&n=22&ordinal=true", http.StatusOK, Result{Locale: "en-GB", Resolved: "en", Number: "22", Ordinal: true, Category: "two"}},
+		{"/plural?locale=pt-AO&n=0", http.StatusOK, Result{Locale: "pt-AO", Resolved: "pt-PT", Number: "0", Category: "other"}},
+		{"/plural?locale=pt-BR&n=0", http.StatusOK, Result{Locale: "pt-BR", Resolved: "pt", Number: "0", Category: "one"}},
+		{"/plural?locale=iw&n=2", http.StatusOK, Result{Locale: "iw", Alias: "he", Resolved: "he", Number: "2", Category: "two"}},
+		{"/plural?locale=no&n=1", http.StatusOK, Result{Locale: "no", Alias: "nb", Resolved: "nb", Number: "1", Category: "one"}},
+		{"/plural?locale=ja&n=1", http.StatusOK, Result{Locale: "ja", Resolved: "ja", Number: "1", Category: "other"}},
+		{"/plural?locale=ru&n=abc", http.StatusBadRequest, Result{}},
+		{"/plural?locale=ru", http.StatusBadRequest, Result{}},
+		{"/plural?locale=ru&n=1&ordinal=maybe", http.StatusBadRequest, Result{}},
+		{"/plural?locale=e!&n=1", http.StatusBadRequest, Result{}},
+	}
+	for _, test := range tests {
+		var result Result
+		if status := do(t, "GET", test.target, "", &result); status != test.status {
+			t.Errorf("GET %s expecting %d but got %d", test.target, test.status, status)
+		}
+		if http.StatusOK == test.status && result != test.expected {
+			t.Errorf("GET %s expecting %+v but got %+v", test.target, test.expected, result)
+		}
+	}
+
+	if status := do(t, "DELETE", "/plural", "", nil); http.StatusMethodNotAllowed != status {
+		t.Errorf("DELETE /plural expecting 405 but got %d", status)
+	}
+}
+
+func TestPluralBatch(t *testing.T) {
+	var results []Result
+	body := `[{"locale": "ru", "n": 3}, {"locale": "fr", "n": "1.50"}, {"locale": "en", "n": 3, "ordinal": true}, {"locale": "ru", "n": "x"}]`
+	if status := do(t, "POST", "/plural", body, &results); http.StatusOK != status {
+		t.Fatalf("POST /plural expecting 200 but got %d", status)
+	}
+	expected := []string{"few", "one", "few", ""}
+	if len(results) != len(expected) {
+		t.Fatalf("POST /plural expecting %d results but got %d", len(expected), len(results))
+	}
+	for i, result := range results {
+		if result.Category != expected[i] {
+			t.Errorf("POST /plural %d expecting <%s> but got <%s>", i, expected[i], result.Category)
+		}
+	}
+	if "" == results[3].Error {
+		t.Errorf("POST /plural expecting the error of `x`")
+	}
+
+	big := "[" + strings.Repeat(`{"locale": "ru", "n": 1},`, maxBatch) + `{"locale": "ru", "n": 1}]`
+	for _, body := range []string{`{`, `{"locale": "ru"}`, big} {
+		if status := do(t, "POST", "/plural", body, nil); http.StatusBadRequest != status {
+			t.Errorf("POST /plural expecting 400 but got %d", status)
+		}
+	}
+}
+
+func TestLocales(t *testing.T) {
+	var locales []Locale
+	if status := do(t, "GET", "/locales", "", &locales); http.StatusOK != status {
+		t.Fatalf("GET /locales expecting 200 but got %d", status)
+	}
+	found := false
+	for _, l := range locales {
+		if "cy" == l.Locale {
+			found = true
+			if 6 != len(l.Cardinal) || !l.HasOrdinal {
+				t.Errorf("`cy` expecting 6 cardinal categories and ordinals but got %+v", l)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("GET /locales expecting `cy`")
+	}
+}
+
+func TestRules(t *testing.T) {
+	var rules Rules
+	if status := do(t, "GET", "/rules/pt-PT", "", &rules); http.StatusOK != status {
+		t.Fatalf("GET /rules/pt-PT expecting 200 but got %d", status)
+	}
+	if "pt-PT" != rules.Resolved || 1 != len(rules.Cardinal) || "one" != rules.Cardinal[0].Form || 0 == len(rules.Tests.Cardinal) {
+		t.Errorf("GET /rules/pt-PT unexpected %+v", rules)
+	}
+
+	if status := do(t, "GET", "/rules/ja", "", &rules); http.StatusOK != status || 0 != len(rules.Cardinal) {
+		t.Errorf("GET /rules/ja expecting no conditions but got %d %+v", status, rules)
+	}
+	if status := do(t, "GET", "/rules/e!", "", nil); http.StatusBadRequest != status {
+		t.Errorf("GET /rules/e! expecting 400 but got %d", status)
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	var doc struct {
+		Paths map[string]interface{} `json:"paths"`
+	}
+	if status := do(t, "GET", "/openapi.json", "", &doc); http.StatusOK != status {
+		t.Fatalf("GET /openapi.json expecting 200 but got %d", status)
+	}
+	for _, path := range []string{"/plural", "/locales", "/rules/{locale}"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("OpenAPI expecting %s", path)
+		}
+	}
+}
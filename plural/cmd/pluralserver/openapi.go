@@ -0,0 +1,134 @@
+package main
+
+// openAPI describes the endpoints, served by GET /openapi.json.
+const openAPI = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "pluralserver",
+    "description": "CLDR plural categories of numbers, from the rules of the plural package.",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/plural": {
+      "get": {
+        "summary": "Category of a number in a locale",
+        "parameters": [
+          {"name": "locale", "in": "query", "required": true, "schema": {"type": "string"}, "example": "ru"},
+          {"name": "n", "in": "query", "required": true, "description": "Decimal number, \"1.50\" keeping its visible fraction digits", "schema": {"type": "string"}, "example": "21"},
+          {"name": "ordinal", "in": "query", "schema": {"type": "boolean", "default": false}}
+        ],
+        "responses": {
+          "200": {"description": "Category", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Result"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "post": {
+        "summary": "Categories of up to 1000 numbers",
+        "requestBody": {
+          "required": true,
+          "content": {"application/json": {"schema": {"type": "array", "maxItems": 1000, "items": {"$ref": "#/components/schemas/Request"}}}}
+        },
+        "responses": {
+          "200": {"description": "Categories in the order of the request, with the error of each number that failed", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Result"}}}}},
+          "400": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/locales": {
+      "get": {
+        "summary": "Supported locales and their categories",
+        "responses": {
+          "200": {"description": "Locales", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Locale"}}}}}
+        }
+      }
+    },
+    "/rules/{locale}": {
+      "get": {
+        "summary": "Plural rules of a locale, those of its parent when it has none",
+        "parameters": [
+          {"name": "locale", "in": "path", "required": true, "schema": {"type": "string"}, "example": "pt-PT"}
+        ],
+        "responses": {
+          "200": {"description": "Rules", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Rules"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    }
+  },
+  "components": {
+    "responses": {
+      "Error": {"description": "Invalid request or unknown locale", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    },
+    "schemas": {
+      "Category": {"type": "string", "enum": ["zero", "one", "two", "few", "many", "other"]},
+      "Request": {
+        "type": "object",
+        "required": ["locale", "n"],
+        "properties": {
+          "locale": {"type": "string"},
+          "n": {"oneOf": [{"type": "number"}, {"type": "string"}]},
+          "ordinal": {"type": "boolean", "default": false}
+        }
+      },
+      "Result": {
+        "type": "object",
+        "properties": {
+          "locale": {"type": "string"},
+          "alias": {"type": "string", "description": "Canonical locale of a deprecated or legacy code, he for iw"},
+          "resolved": {"type": "string", "description": "Locale whose rules were used"},
+          "n": {"type": "string"},
+          "ordinal": {"type": "boolean"},
+          "category": {"$ref": "#/components/schemas/Category"},
+          "error": {"type": "string"}
+        }
+      },
+      "Locale": {
+        "type": "object",
+        "properties": {
+          "locale": {"type": "string"},
+          "cardinal": {"type": "array", "items": {"$ref": "#/components/schemas/Category"}},
+          "ordinal": {"type": "array", "items": {"$ref": "#/components/schemas/Category"}},
+          "hasOrdinal": {"type": "boolean"}
+        }
+      },
+      "Case": {
+        "type": "object",
+        "properties": {
+          "form": {"$ref": "#/components/schemas/Category"},
+          "cond": {"type": "string", "example": "n10 == 1 && n100 != 11"}
+        }
+      },
+      "UnitTest": {
+        "type": "object",
+        "properties": {
+          "expected": {"$ref": "#/components/schemas/Category"},
+          "integers": {"type": "array", "items": {"type": "string"}},
+          "decimals": {"type": "array", "items": {"type": "string"}}
+        }
+      },
+      "Rules": {
+        "type": "object",
+        "properties": {
+          "locale": {"type": "string"},
+          "alias": {"type": "string"},
+          "resolved": {"type": "string"},
+          "langs": {"type": "array", "items": {"type": "string"}},
+          "cardinal": {"type": "array", "items": {"$ref": "#/components/schemas/Case"}},
+          "ordinal": {"type": "array", "items": {"$ref": "#/components/schemas/Case"}},
+          "hasOrdinal": {"type": "boolean"},
+          "tests": {
+            "type": "object",
+            "properties": {
+              "cardinal": {"type": "array", "items": {"$ref": "#/components/schemas/UnitTest"}},
+              "ordinal": {"type": "array", "items": {"$ref": "#/components/schemas/UnitTest"}}
+            }
+          }
+        }
+      },
+      "Error": {"type": "object", "properties": {"error": {"type": "string"}}}
+    }
+  }
+}
+`
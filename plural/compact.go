@@ -0,0 +1,152 @@
+package plural
+
+import (
+	"sort"
+)
+
+// opcode is an instruction of the compact rule bytecode emitted into
+// tables.go by make-plural.
+//
+// A condition is compiled into a flat sequence of instructions working on a
+// single boolean accumulator: comparisons overwrite it, opAnd and opOr
+// short-circuit by jumping to their target when the accumulator already
+// decides the result.
+type opcode uint8
+
+const (
+	opEq  opcode = iota // acc = operand == arg
+	opNe                // acc = operand != arg
+	opLt                // acc = operand < arg
+	opGt                // acc = operand > arg
+	opLe                // acc = operand <= arg
+	opGe                // acc = operand >= arg
+	opAnd               // if !acc, jump to arg
+	opOr                // if acc, jump to arg
+)
+
+// instr is a single bytecode instruction. sym and mod select the operand
+// (mod 0 means the operand itself, otherwise operand % mod), arg holds the
+// compared constant or the jump target, relative to the first instruction of
+// the condition.
+type instr struct {
+	op  opcode
+	sym Symbol
+	mod int32
+	arg int32
+}
+
+// rule is a plural category guarded by the condition code[start:end].
+type rule struct {
+	form       Category
+	start, end uint16
+}
+
+// ruleSet holds the compiled rules shared by every locale having the same
+// CLDR data. Without ordinal data, ordinals are only "other".
+type ruleSet struct {
+	cardinal []rule
+	ordinal  []rule
+}
+
+func (o *Operands) get(sym Symbol, mod int32) float64 {
+	var x int64
+	switch sym {
+	case N:
+		if mod != 0 {
+			return o.nmod(int64(mod))
+		}
+		return o.N
+	case P:
+		if o.W == 0 {
+			return 1
+		}
+		return 0
+	case I:
+		x = o.I
+	case F:
+		x = o.F
+	case T:
+		x = o.T
+	case V:
+		x = int64(o.V)
+	case W:
+		x = int64(o.W)
+	case E:
+		x = int64(o.E)
+	}
+	if mod != 0 {
+		x %= int64(mod)
+	}
+	return float64(x)
+}
+
+func (o *Operands) match(code []instr) bool {
+	var acc bool
+	for pc := 0; pc < len(code); pc++ {
+		in := &code[pc]
+		switch in.op {
+		case opAnd:
+			if !acc {
+				pc = int(in.arg) - 1
+			}
+		case opOr:
+			if acc {
+				pc = int(in.arg) - 1
+			}
+		case opEq:
+			acc = o.get(in.sym, in.mod) == float64(in.arg)
+		case opNe:
+			acc = o.get(in.sym, in.mod) != float64(in.arg)
+		case opLt:
+			acc = o.get(in.sym, in.mod) < float64(in.arg)
+		case opGt:
+			acc = o.get(in.sym, in.mod) > float64(in.arg)
+		case opLe:
+			acc = o.get(in.sym, in.mod) <= float64(in.arg)
+		case opGe:
+			acc = o.get(in.sym, in.mod) >= float64(in.arg)
+		}
+	}
+	return acc
+}
+
+func (o *Operands) form(rules []rule) Category {
+	for _, r := range rules {
+		if o.match(ruleCode[r.start:r.end]) {
+			return r.form
+		}
+	}
+	return Other
+}
+
+func (rs *ruleSet) rules(ordinal bool) []rule {
+	if ordinal {
+		return rs.ordinal
+	}
+	return rs.cardinal
+}
+
+func (rs *ruleSet) evalOperands(o Operands, ordinal bool) Category {
+	// ordinals are only defined for integers
+	if ordinal && o.W != 0 {
+		return Other
+	}
+	return o.form(rs.rules(ordinal))
+}
+
+func (rs *ruleSet) eval(value interface{}, ordinal bool) string {
+	rules := rs.rules(ordinal)
+	if len(rules) == 0 {
+		return "other"
+	}
+	return rs.evalOperands(operandsOf(value), ordinal).String()
+}
+
+// findRuleSet returns the compiled rules of the given canonical tag.
+func findRuleSet(tag string) (*ruleSet, bool) {
+	idx := sort.SearchStrings(ruleSetTags[:], tag)
+	if idx == len(ruleSetTags) || ruleSetTags[idx] != tag {
+		return nil, false
+	}
+	return &ruleSets[ruleSetIndex[idx]], true
+}
@@ -0,0 +1,29 @@
+//go:build plural_compact
+// +build plural_compact
+
+package plural
+
+import (
+	"golang.org/x/text/language"
+)
+
+// generatedFunc returns the plural function of the given culture taking
+// values, served by the compact rule tables instead of the generated
+// closures.
+func generatedFunc(culture language.Tag) (func(interface{}, bool) string, bool) {
+	rs, ok := findRuleSet(culture.String())
+	if !ok {
+		return nil, false
+	}
+	return rs.eval, true
+}
+
+// generatedOperandsFunc returns the plural function of the given culture
+// taking operands, served by the compact rule tables.
+func generatedOperandsFunc(culture language.Tag) (func(Operands, bool) Category, bool) {
+	rs, ok := findRuleSet(culture.String())
+	if !ok {
+		return nil, false
+	}
+	return rs.evalOperands, true
+}
@@ -0,0 +1,59 @@
+//go:build !plural_compact
+// +build !plural_compact
+
+package plural
+
+import (
+	"strconv"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func compactTestValues(c *Culture) []interface{} {
+	values := []interface{}{-21, -1, "-1.5", 0.0, 0.5, 1.0, 2.5, 1e6, "1e3", "abc"}
+	for i := 0; i <= 120; i++ {
+		values = append(values, i, strconv.Itoa(i)+".0", strconv.Itoa(i)+".25")
+	}
+	for _, uts := range [][]UnitTest{c.Tests.Cardinal, c.Tests.Ordinal} {
+		for _, ut := range uts {
+			for _, v := range ut.Integers {
+				i, _ := strconv.Atoi(v)
+				values = append(values, i)
+			}
+			for _, v := range ut.Decimals {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+func TestCompactMatchesClosures(t *testing.T) {
+	pluralFuncsOnce.Do(loadPluralFuncs)
+	for i, tag := range ruleSetTags {
+		fn, ok := value_funcs[language.MustParse(tag)]
+		if !ok {
+			t.Errorf("`%s` has no generated closure", tag)
+			continue
+		}
+
+		rs := &ruleSets[ruleSetIndex[i]]
+		var c Culture
+		if found, _, ok := Info.Find(language.MustParse(tag)); ok && found != nil {
+			c = *found
+		}
+		for _, value := range compactTestValues(&c) {
+			for _, ordinal := range []bool{false, true} {
+				expected, result := fn(value, ordinal), rs.eval(value, ordinal)
+				if expected != result {
+					t.Errorf("`%s` fn(%#v, %v): closure <%s> but compact <%s>", tag, value, ordinal, expected, result)
+				}
+			}
+		}
+	}
+
+	if len(ruleSetTags) != len(value_funcs) {
+		t.Errorf("expecting %d compact tags but got %d", len(value_funcs), len(ruleSetTags))
+	}
+}
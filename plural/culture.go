@@ -2,16 +2,42 @@ package plural
 
 import (
 	"strconv"
+	"sync"
 
 	"golang.org/x/text/language"
 )
 
+// PluralInfo is safe for concurrent use once built, Cultures, Others, Parents,
+// LikelySubtags and Aliases must not be modified after the first lookup.
 type PluralInfo struct {
 	Cultures []Culture
 	Others   []string
 
-	culturesMap map[language.Tag]*Culture
-	othersMap   map[language.Tag]bool
+	// OrdinalOthers are the Others having CLDR ordinal data, made of "other"
+	// only. The Cultures having ordinal data set OrdinalData.
+	OrdinalOthers []string
+
+	// Parents are the CLDR parent locales leading to the rules of a region
+	// or a script, such as "pt-AO": "pt-PT". The other locales inherit the
+	// rules of their language.
+	Parents map[string]string
+
+	// LikelySubtags are the CLDR likely subtags of the languages having
+	// rules for a region or a script, such as "sr": "sr-Cyrl-RS" and
+	// "sr-ME": "sr-Latn-ME".
+	LikelySubtags map[string]string
+
+	// Aliases are the CLDR replacements of the deprecated and legacy codes
+	// of the languages having rules, such as "iw": "he" and "sh": "sr-Latn".
+	Aliases map[string]string
+
+	once             sync.Once
+	culturesMap      map[language.Tag]*Culture
+	othersMap        map[language.Tag]bool
+	ordinalOthersMap map[language.Tag]bool
+	parentsMap       map[language.Tag]language.Tag
+	likelyMap        map[language.Tag]language.Tag
+	aliasMap         map[string]language.Tag
 }
 
 func (pi *PluralInfo) Validate(langs []string) (parseFailed, findFailed []string, ok bool) {
@@ -41,62 +67,175 @@ func (pi *PluralInfo) Langs() []string {
 	return all
 }
 
+// Find returns the culture whose rules lang uses, nil for the Others, and the
+// tag it was found on. Without rules of its own, lang inherits those of its
+// parents, as Parent returns them: "pt-AO" uses the rules of "pt-PT",
+// "sr-Latn-BA" those of "sr-Latn" and "en-GB" those of "en".
 func (pi *PluralInfo) Find(lang language.Tag) (c *Culture, on language.Tag, found bool) {
-	on = lang
-	c, found = pi.CulturesMap()[lang]
-	if found {
-		return
+	canonical, _ := pi.Canonicalize(lang)
+	for tag, ok := canonical, true; ok; tag, ok = pi.Parent(tag) {
+		if c, found := pi.culturesMap[tag]; found {
+			return c, tag, true
+		}
+		if pi.othersMap[tag] {
+			return nil, tag, true
+		}
 	}
+	return nil, lang, false
+}
+
+// Parent returns the tag whose rules lang inherits, false for und. Following
+// the CLDR inheritance, it is lang without its variants and extensions, then
+// its parent locale in Parents, lang without its region, or without its
+// script when it is the likely one of the region ("pt-Latn-AO" is "pt-AO"),
+// then the language and und. A region whose likely script differs from the
+// one of the language keeps it: the parent of "sr-ME", which is "sr-Latn-ME",
+// is "sr-Latn".
+func (pi *PluralInfo) Parent(lang language.Tag) (language.Tag, bool) {
+	pi.once.Do(pi.buildMaps)
 
-	found = pi.IsOthers(lang)
-	if found {
-		return
+	base, script, region := lang.Raw()
+	if plain := composeTag(base, script, region); plain != lang {
+		return plain, true
+	}
+	if parent, ok := pi.parentsMap[lang]; ok {
+		return parent, true
 	}
 
-	base, confidence := lang.Base()
-	if confidence == language.No {
-		return
+	var noScript language.Script
+	var noRegion language.Region
+	_, likelyScript, _ := pi.likelyMap[composeTag(base, noScript, noRegion)].Raw()
+	switch {
+	case noRegion != region && noScript == script:
+		if likely, ok := pi.likelyMap[lang]; ok {
+			if _, s, _ := likely.Raw(); s != likelyScript {
+				return composeTag(base, s, noRegion), true
+			}
+		}
+		return composeTag(base, noScript, noRegion), true
+
+	case noRegion != region:
+		if likely, ok := pi.likelyMap[composeTag(base, noScript, region)]; ok {
+			_, likelyScript, _ = likely.Raw()
+		}
+		if script == likelyScript {
+			return composeTag(base, noScript, region), true
+		}
+		return composeTag(base, script, noRegion), true
+
+	case noScript != script:
+		return composeTag(base, noScript, noRegion), true
+
+	case language.Und != lang:
+		return language.Und, true
 	}
+	return lang, false
+}
+
+// Canonicalize returns lang with its language code replaced as CLDR aliases
+// it, "iw" being "he", "no" "nb" and "sh-RS" "sr-Latn-RS", true when it was
+// replaced. language.Parse already replaces the most common of them, tags
+// parsed by language.Raw.Parse tell the alias applied.
+func (pi *PluralInfo) Canonicalize(lang language.Tag) (language.Tag, bool) {
+	pi.once.Do(pi.buildMaps)
 
-	lang2, err := language.Compose(base)
-	if err != nil {
-		return
+	base, script, region := lang.Raw()
+	alias, ok := pi.aliasMap[base.String()]
+	if !ok {
+		canonical, err := language.Default.Canonicalize(lang)
+		return canonical, nil == err && canonical != lang
 	}
 
-	if lang2 == lang {
-		lang2 = lang.Parent()
+	aliasBase, aliasScript, aliasRegion := alias.Raw()
+	if (language.Script{}) == script {
+		script = aliasScript
 	}
+	if (language.Region{}) == region {
+		region = aliasRegion
+	}
+	parts := []interface{}{lang, aliasBase, script, region}
+	canonical, err := language.Compose(parts...)
+	if nil != err {
+		return lang, false
+	}
+	return canonical, true
+}
 
-	return pi.Find(lang2)
+func composeTag(base language.Base, script language.Script, region language.Region) language.Tag {
+	parts := []interface{}{base}
+	if (language.Script{}) != script {
+		parts = append(parts, script)
+	}
+	if (language.Region{}) != region {
+		parts = append(parts, region)
+	}
+	tag, _ := language.Compose(parts...)
+	return tag
 }
 
-func (pi *PluralInfo) CulturesMap() map[language.Tag]*Culture {
-	if pi.culturesMap == nil {
-		pi.culturesMap = make(map[language.Tag]*Culture, 256)
-		for i := range pi.Cultures {
-			for _, lang := range pi.Cultures[i].Langs {
-				pi.culturesMap[language.MustParse(lang)] = &pi.Cultures[i]
-			}
+func (pi *PluralInfo) buildMaps() {
+	pi.culturesMap = make(map[language.Tag]*Culture, 256)
+	for i := range pi.Cultures {
+		for _, lang := range pi.Cultures[i].Langs {
+			pi.culturesMap[language.MustParse(lang)] = &pi.Cultures[i]
 		}
 	}
+
+	pi.othersMap = make(map[language.Tag]bool, len(pi.Others))
+	for _, lang := range pi.Others {
+		pi.othersMap[language.MustParse(lang)] = true
+	}
+
+	pi.ordinalOthersMap = make(map[language.Tag]bool, len(pi.OrdinalOthers))
+	for _, lang := range pi.OrdinalOthers {
+		pi.ordinalOthersMap[language.MustParse(lang)] = true
+	}
+
+	pi.parentsMap = make(map[language.Tag]language.Tag, len(pi.Parents))
+	for lang, parent := range pi.Parents {
+		pi.parentsMap[language.MustParse(lang)] = language.MustParse(parent)
+	}
+
+	pi.likelyMap = make(map[language.Tag]language.Tag, len(pi.LikelySubtags))
+	for lang, likely := range pi.LikelySubtags {
+		pi.likelyMap[language.MustParse(lang)] = language.MustParse(likely)
+	}
+
+	pi.aliasMap = make(map[string]language.Tag, len(pi.Aliases))
+	for code, replacement := range pi.Aliases {
+		pi.aliasMap[code] = language.MustParse(replacement)
+	}
+}
+
+func (pi *PluralInfo) CulturesMap() map[language.Tag]*Culture {
+	pi.once.Do(pi.buildMaps)
 	return pi.culturesMap
 }
 
 func (pi *PluralInfo) IsOthers(cultrue language.Tag) bool {
-	if pi.othersMap == nil {
-		pi.othersMap = make(map[language.Tag]bool, len(pi.Others))
-		for _, lang := range pi.Others {
-			pi.othersMap[language.MustParse(lang)] = true
-		}
-	}
+	pi.once.Do(pi.buildMaps)
 	return pi.othersMap[cultrue]
 }
 
+// HasOrdinal tells whether CLDR has ordinal data for the culture found for
+// lang.
+func (pi *PluralInfo) HasOrdinal(lang language.Tag) bool {
+	c, on, found := pi.Find(lang)
+	switch {
+	case !found:
+		return false
+	case nil == c:
+		pi.once.Do(pi.buildMaps)
+		return pi.ordinalOthersMap[on]
+	}
+	return c.OrdinalData
+}
+
 type Culture struct {
 	Langs []string
 
 	// Symbols plus P
-	F, I, N, V, T, W, P Symbol
+	F, I, N, V, T, W, E, P Symbol
 
 	// Cardinal defines the plural rules for numbers indicating quantities.
 	Cardinal Cases
@@ -105,6 +244,10 @@ type Culture struct {
 	// (first, second, etc.).
 	Ordinal Cases
 
+	// OrdinalData tells CLDR has ordinal data for the culture, even when
+	// made of "other" only. Its ordinals are "other" otherwise.
+	OrdinalData bool
+
 	// Vars only come from mod
 	Vars []Var
 
@@ -119,6 +262,7 @@ func (c Culture) HasVars() bool {
 		c.V.Use() ||
 		c.T.Use() ||
 		c.W.Use() ||
+		c.E.Use() ||
 		c.P.Use()
 }
 func (c Culture) NeedFinvtw() bool      { return c.F.Use() || c.V.Use() || c.T.Use() || c.W.Use() }
@@ -0,0 +1,171 @@
+package plural
+
+import (
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// Run with -race.
+func TestPluralInfoConcurrent(t *testing.T) {
+	var pi = PluralInfo{Cultures: Info.Cultures, Others: Info.Others}
+	langs := Info.Langs()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(3)
+		go func(i int) {
+			defer wg.Done()
+			for _, lang := range langs[i:] {
+				if _, _, found := pi.Find(language.MustParse(lang)); !found {
+					t.Errorf("`%s` not found", lang)
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			if parseFailed, findFailed, ok := pi.Validate(langs); !ok {
+				t.Errorf("Validate failed: %v %v", parseFailed, findFailed)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if len(pi.Langs()) != len(langs) {
+				t.Errorf("Langs length mismatch")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPluralInfoFind(t *testing.T) {
+	tests := []struct {
+		lang, culture string
+		others        bool
+	}{
+		{"en", "en", false},
+		{"en-US", "en", false},
+		{"en-US-u-nu-arab", "en", false},
+		{"pt-PT", "pt-PT", false},
+		{"pt-AO", "pt-PT", false},
+		{"pt-MZ-u-ca-gregory", "pt-PT", false},
+		{"pt-Latn-AO", "pt-PT", false},
+		{"pt-BR", "pt", false},
+		{"pt-Latn-BR", "pt", false},
+		{"ro-MD", "ro-MD", false},
+		{"ro-Latn-MD", "ro-MD", false},
+		{"sr-Latn-BA", "sr-Latn", false},
+		{"sr-ME", "sr-Latn", false},
+		{"sr-RS", "sr", false},
+		{"sr-Cyrl-ME", "sr", false},
+		{"ja", "", true},
+		{"zh-Hant-TW", "", true},
+		{"und-PT", "", true},
+		{"no", "nb", false},
+		{"sh-RS", "sr-Latn", false},
+		{"in", "", true},
+	}
+	for _, test := range tests {
+		c, on, found := Info.Find(language.MustParse(test.lang))
+		if !found {
+			t.Errorf("`%s` not found", test.lang)
+			continue
+		}
+		if test.others {
+			if c != nil {
+				t.Errorf("`%s` expecting others but got %v", test.lang, c.Langs)
+			}
+			continue
+		}
+		if c == nil || !containsLang(c.Langs, test.culture) || language.MustParse(test.culture) != on {
+			t.Errorf("`%s` expecting culture of `%s` but got `%s`", test.lang, test.culture, on)
+		}
+	}
+}
+
+func containsLang(langs []string, lang string) bool {
+	for _, l := range langs {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPluralInfoParent(t *testing.T) {
+	info := &PluralInfo{}
+	tests := []struct {
+		lang, parent string
+	}{
+		{"pt-AO", "pt"},
+		{"sr-ME", "sr"},
+		{"sr-Latn-ME", "sr-Latn"},
+		{"de-CH-1901", "de-CH"},
+		{"de", "und"},
+	}
+	for _, test := range tests {
+		parent, ok := info.Parent(language.MustParse(test.lang))
+		if !ok || language.MustParse(test.parent) != parent {
+			t.Errorf("`%s` expecting parent `%s` but got `%s`", test.lang, test.parent, parent)
+		}
+	}
+	if parent, ok := info.Parent(language.Und); ok {
+		t.Errorf("`und` expecting no parent but got `%s`", parent)
+	}
+
+	// the parent locales of Info
+	if parent, _ := Info.Parent(language.MustParse("pt-AO")); language.MustParse("pt-PT") != parent {
+		t.Errorf("`pt-AO` expecting parent `pt-PT` but got `%s`", parent)
+	}
+}
+
+func TestPluralInfoCanonicalize(t *testing.T) {
+	tests := []struct {
+		lang, canonical string
+		aliased         bool
+	}{
+		{"iw", "he", true},
+		{"tl", "fil", true},
+		{"no", "nb", true},
+		{"sh", "sr-Latn", true},
+		{"sh-RS", "sr-Latn-RS", true},
+		{"sh-Cyrl", "sr-Cyrl", true},
+		{"no-u-nu-arab", "nb-u-nu-arab", true},
+		{"heb", "he", true},
+		{"en-GB", "en-GB", false},
+	}
+	for _, test := range tests {
+		lang, err := language.Raw.Parse(test.lang)
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		canonical, aliased := Info.Canonicalize(lang)
+		if language.MustParse(test.canonical) != canonical || aliased != test.aliased {
+			t.Errorf("`%s` expecting `%s` (%v) but got `%s` (%v)", test.lang, test.canonical, test.aliased, canonical, aliased)
+		}
+	}
+}
+
+func TestPluralInfoHasOrdinal(t *testing.T) {
+	// the cultures have no ordinal samples, only OrdinalData tells
+	info := &PluralInfo{
+		Cultures: []Culture{
+			{Langs: []string{"fr"}, Cardinal: Cases{{Form: "one", Cond: "i == 0 || i == 1"}}, OrdinalData: true},
+			{Langs: []string{"de"}, Cardinal: Cases{{Form: "one", Cond: "i == 1 && v == 0"}}},
+		},
+		Others:        []string{"ja", "bm"},
+		OrdinalOthers: []string{"ja"},
+	}
+	for lang, expected := range map[string]bool{"fr": true, "fr-CA": true, "de": false, "ja": true, "bm": false, "ru": false} {
+		if result := info.HasOrdinal(language.MustParse(lang)); expected != result {
+			t.Errorf("`%s` expecting <%v> but got <%v>", lang, expected, result)
+		}
+	}
+
+	for lang, expected := range map[string]bool{"en": true, "ru": true, "ja": true, "ak": false, "bm": false} {
+		if result := Info.HasOrdinal(language.MustParse(lang)); expected != result {
+			t.Errorf("`%s` Info expecting <%v> but got <%v>", lang, expected, result)
+		}
+	}
+}
@@ -1,11 +1,20 @@
 // Generated by https://github.com/empirefox/makeplural
-// at 2020-04-01 08:35:34.293752 +0000 UTC
+// at 2026-10-18 19:13:59.876514807 +0000 UTC
 //
 // URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json
 //
 //
 // URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json
 //
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/parentLocales.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/likelySubtags.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/aliases.json
+//
 
 package plural
 
@@ -18,6 +27,8 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "n == 1"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
@@ -27,14 +38,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -55,8 +66,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -68,23 +79,25 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "i == 0 || n == 1"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.0", "0.00", "0.04"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"1.1", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -100,6 +113,7 @@ var Info = PluralInfo{
 				{Form: "many", Cond: "p && n100 >= 11 && n100 <= 99"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 100},
 			},
@@ -117,12 +131,12 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"3", "10", "103", "110", "1003"},
+						Integers: []string{"3", "4", "5", "6", "7", "8", "9", "10", "103", "104", "105", "106", "107", "108", "109", "110", "1003"},
 						Decimals: []string{"3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "103.0", "1003.0"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"11", "26", "111", "1011"},
+						Integers: []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "111", "1011"},
 						Decimals: []string{"11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0"},
 					},
 					{
@@ -132,14 +146,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"100", "102", "200", "202", "300", "302", "400", "402", "500", "502", "600", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"100", "101", "102", "200", "201", "202", "300", "301", "302", "400", "401", "402", "500", "501", "502", "600", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -172,12 +186,12 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"3", "10", "103", "110", "1003"},
+						Integers: []string{"3", "4", "5", "6", "7", "8", "9", "10", "103", "104", "105", "106", "107", "108", "109", "110", "1003"},
 						Decimals: []string{"3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "103.0", "1003.0"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"11", "26", "111", "1011"},
+						Integers: []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "111", "1011"},
 						Decimals: []string{"11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0"},
 					},
 					{
@@ -187,8 +201,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"100", "102", "200", "202", "300", "302", "400", "402", "500", "502", "600", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"100", "101", "102", "200", "201", "202", "300", "301", "302", "400", "401", "402", "500", "501", "502", "600", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -205,24 +219,25 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "n == 4"},
 				{Form: "many", Cond: "n == 6"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.0", "0.00", "0.04"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"1.1", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"1", "5", "7", "10"},
+						Integers: []string{"1", "5", "7", "8", "9", "10"},
 					},
 					{
 						Expected: "two",
@@ -238,7 +253,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "11", "25", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -259,8 +274,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -280,8 +295,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -297,6 +312,7 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "i10 == 3 || i10 == 4 || i1000 == 100 || i1000 == 200 || i1000 == 300 || i1000 == 400 || i1000 == 500 || i1000 == 600 || i1000 == 700 || i1000 == 800 || i1000 == 900"},
 				{Form: "many", Cond: "i == 0 || i10 == 6 || i100 == 40 || i100 == 60 || i100 == 90"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: I, Mod: 100},
@@ -311,14 +327,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"1", "2", "5", "7", "8", "11", "12", "15", "17", "18", "20", "22", "25", "101", "1001"},
+						Integers: []string{"1", "2", "5", "7", "8", "11", "12", "15", "17", "18", "20", "21", "22", "25", "101", "1001"},
 					},
 					{
 						Expected: "few",
@@ -346,6 +362,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "few", Cond: "(n10 == 2 || n10 == 3) && n100 != 12 && n100 != 13"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -359,18 +376,18 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "4", "22", "24", "32", "34", "42", "44", "52", "54", "62", "102", "1002"},
+						Integers: []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
 						Decimals: []string{"2.0", "3.0", "4.0", "22.0", "23.0", "24.0", "32.0", "33.0", "102.0", "1002.0"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 						Decimals: []string{"0.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "11.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
 
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.1", "100.1", "1000.1"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.1", "1000.1"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -380,7 +397,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "1", "4", "17", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -424,8 +441,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "8", "10", "20", "100", "1000", "10000", "100000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0"},
+						Integers: []string{"0", "5", "6", "7", "8", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0"},
 					},
 				},
 			},
@@ -438,6 +455,7 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14) || f10 >= 2 && f10 <= 4 && (f100 < 12 || f100 > 14)"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: I, Mod: 100},
@@ -453,19 +471,19 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "4", "22", "24", "32", "34", "42", "44", "52", "54", "62", "102", "1002"},
-						Decimals: []string{"0.2", "0.4", "1.2", "1.4", "2.2", "2.4", "3.2", "3.4", "4.2", "4.4", "5.2", "10.2", "100.2", "1000.2"},
+						Integers: []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
+						Decimals: []string{"0.2", "0.3", "0.4", "1.2", "1.3", "1.4", "2.2", "2.3", "2.4", "3.2", "3.3", "3.4", "4.2", "4.3", "4.4", "5.2", "10.2", "100.2", "1000.2"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.5", "1.0", "1.5", "2.0", "2.5", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.5", "2.6", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -481,6 +499,7 @@ var Info = PluralInfo{
 				{Form: "two", Cond: "n == 2"},
 				{Form: "few", Cond: "n == 4"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -490,8 +509,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -509,7 +528,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -529,8 +548,8 @@ var Info = PluralInfo{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"0", "3", "5", "7", "8", "10", "13", "15", "17", "18", "20", "21", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.3", "0.5", "0.7", "0.8", "1.0", "1.3", "1.5", "1.7", "1.8", "2.0", "2.1", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "1", "2", "3", "5", "7", "8", "10", "11", "12", "13", "15", "17", "18", "20", "21", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.5", "0.7", "0.8", "1.0", "1.1", "1.2", "1.3", "1.5", "1.7", "1.8", "2.0", "2.1", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
@@ -549,6 +568,8 @@ var Info = PluralInfo{
 				{Form: "many", Cond: "v != 0"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
@@ -557,22 +578,22 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "4"},
+						Integers: []string{"2", "3", "4"},
 					},
 					{
 						Expected: "many",
 
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -594,6 +615,7 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "n == 3 || n == 4"},
 				{Form: "many", Cond: "n == 5 || n == 6"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -624,8 +646,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"4", "5", "7", "20", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"4", "5", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -647,11 +669,11 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "zero",
-						Integers: []string{"0", "7", "9"},
+						Integers: []string{"0", "7", "8", "9"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"10", "25", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -663,23 +685,25 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "n == 1 || t != 0 && (i == 0 || i == 1)"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
 						Integers: []string{"1"},
-						Decimals: []string{"0.1", "1.6"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "2.0", "3.4", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -691,6 +715,8 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "i == 1 && v == 0"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
@@ -699,14 +725,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -720,6 +746,7 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "v == 0 && i100 >= 3 && i100 <= 4 || f100 >= 3 && f100 <= 4"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 100},
 				{Symbol: F, Mod: 100},
@@ -743,14 +770,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.5", "1.0", "1.5", "2.0", "2.5", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.5", "2.6", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -766,6 +793,7 @@ var Info = PluralInfo{
 				{Form: "two", Cond: "n10 == 2 && n100 != 12"},
 				{Form: "few", Cond: "n10 == 3 && n100 != 13"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -778,8 +806,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -797,7 +825,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "4", "18", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -814,12 +842,12 @@ var Info = PluralInfo{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.5"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"2.0", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -833,6 +861,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "n == 1"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: F, Mod: 10},
@@ -841,8 +870,8 @@ var Info = PluralInfo{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"0", "3", "5", "7", "8", "10", "13", "15", "17", "18", "20", "21", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.3", "0.5", "0.7", "0.8", "1.0", "1.3", "1.5", "1.7", "1.8", "2.0", "2.1", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "1", "2", "3", "5", "7", "8", "10", "11", "12", "13", "15", "17", "18", "20", "21", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.5", "0.7", "0.8", "1.0", "1.1", "1.2", "1.3", "1.5", "1.7", "1.8", "2.0", "2.1", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
@@ -857,7 +886,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -871,18 +900,19 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "n == 1"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.5"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"2.0", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -892,7 +922,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -909,6 +939,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "n == 1"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -924,18 +955,18 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"3", "6"},
+						Integers: []string{"3", "4", "5", "6"},
 						Decimals: []string{"3.0", "4.0", "5.0", "6.0", "3.00", "4.00", "5.00", "6.00", "3.000", "4.000", "5.000", "6.000", "3.0000", "4.0000", "5.0000", "6.0000"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"7", "10"},
+						Integers: []string{"7", "8", "9", "10"},
 						Decimals: []string{"7.0", "8.0", "9.0", "10.0", "7.00", "8.00", "9.00", "10.00", "7.000", "8.000", "9.000", "10.000", "7.0000", "8.0000", "9.0000", "10.0000"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "11", "25", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -945,7 +976,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -963,6 +994,7 @@ var Info = PluralInfo{
 				{Form: "two", Cond: "n == 2 || n == 12"},
 				{Form: "few", Cond: "n == 3 || n == 13"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -978,13 +1010,13 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"3", "10", "13", "19"},
+						Integers: []string{"3", "4", "5", "6", "7", "8", "9", "10", "13", "14", "15", "16", "17", "18", "19"},
 						Decimals: []string{"3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "19.0", "3.00"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "20", "34", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1002,7 +1034,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "4", "10", "14", "21", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "4", "5", "6", "7", "8", "9", "10", "14", "15", "16", "17", "18", "19", "20", "21", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1019,18 +1051,19 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "n == 4"},
 				{Form: "many", Cond: "n == 6"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.0", "0.00", "0.04"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"1.1", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1052,7 +1085,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "7", "20", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1088,11 +1121,11 @@ var Info = PluralInfo{
 					{
 						Expected: "many",
 
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"3", "10", "13", "19", "23", "103", "1003"},
+						Integers: []string{"3", "4", "5", "6", "7", "8", "9", "10", "13", "14", "15", "16", "17", "18", "19", "23", "103", "1003"},
 					},
 				},
 			},
@@ -1106,6 +1139,7 @@ var Info = PluralInfo{
 				{Form: "many", Cond: "p && v == 0 && (n < 0 || n > 10) && n10 == 0"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 			},
@@ -1125,14 +1159,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "3", "17", "101", "1001"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "101", "1001"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1146,6 +1180,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "n == 1 || n == 5"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -1156,8 +1191,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1167,7 +1202,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "4", "6", "17", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1179,6 +1214,7 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "t == 0 && i10 == 1 && i100 != 11 || t != 0"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: I, Mod: 100},
@@ -1188,18 +1224,18 @@ var Info = PluralInfo{
 					{
 						Expected: "one",
 						Integers: []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001"},
-						Decimals: []string{"0.1", "1.6", "10.1", "100.1", "1000.1"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.1", "1000.1"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 						Decimals: []string{"0.0", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1213,6 +1249,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "many", Cond: "n == 11 || n == 8 || n == 80 || n == 800"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -1222,8 +1259,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1233,7 +1270,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "7", "9", "10", "12", "17", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "9", "10", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1260,8 +1297,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "3", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -1276,6 +1313,7 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "i == 1"},
 				{Form: "many", Cond: "i == 0 || i100 >= 2 && i100 <= 20 || i100 == 40 || i100 == 60 || i100 == 80"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 100},
 			},
@@ -1288,8 +1326,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1299,11 +1337,11 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "many",
-						Integers: []string{"0", "2", "16", "102", "1002"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "102", "1002"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"21", "36", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "36", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1317,6 +1355,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "many", Cond: "n10 == 6 || n10 == 9 || n10 == 0 && n != 0"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 			},
@@ -1329,8 +1368,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1340,7 +1379,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "7", "8", "11", "15", "17", "18", "21", "101", "1001"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "7", "8", "11", "12", "13", "14", "15", "17", "18", "21", "101", "1001"},
 					},
 				},
 			},
@@ -1367,8 +1406,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -1387,6 +1426,7 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "p && n >= 1 && n <= 4 || p && n100 >= 1 && n100 <= 4 || p && n100 >= 21 && n100 <= 24 || p && n100 >= 41 && n100 <= 44 || p && n100 >= 61 && n100 <= 64 || p && n100 >= 81 && n100 <= 84"},
 				{Form: "many", Cond: "n == 5 || n100 == 5"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 100},
 			},
@@ -1419,14 +1459,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"4", "19", "100", "1004", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.0", "100.0", "1000.1", "1000000.0"},
+						Integers: []string{"4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1004", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.1", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"1", "4", "21", "24", "41", "44", "61", "64", "101", "1001"},
+						Integers: []string{"1", "2", "3", "4", "21", "22", "23", "24", "41", "42", "43", "44", "61", "62", "63", "64", "101", "1001"},
 					},
 					{
 						Expected: "many",
@@ -1434,7 +1474,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "6", "20", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1452,7 +1492,7 @@ var Info = PluralInfo{
 					{
 						Expected: "one",
 						Integers: []string{"1"},
-						Decimals: []string{"0.1", "1.6"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"},
 					},
 					{
 						Expected: "zero",
@@ -1461,8 +1501,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"2.0", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -1474,13 +1514,14 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "n == 1"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1490,7 +1531,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1504,6 +1545,7 @@ var Info = PluralInfo{
 				{Form: "many", Cond: "f != 0"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -1517,24 +1559,24 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "9", "22", "29", "102", "1002"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "22", "23", "24", "25", "26", "27", "28", "29", "102", "1002"},
 						Decimals: []string{"2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "22.0", "102.0", "1002.0"},
 					},
 					{
 						Expected: "many",
 
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.1", "100.1", "1000.1"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.1", "1000.1"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "10", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000"},
 						Decimals: []string{"0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1547,6 +1589,7 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "n10 == 1 && n100 != 11 || v == 2 && f10 == 1 && f100 != 11 || v != 2 && f10 == 1"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -1562,19 +1605,19 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "zero",
-						Integers: []string{"0", "10", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000"},
 						Decimals: []string{"0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "9", "22", "29", "102", "1002"},
-						Decimals: []string{"0.2", "0.9", "1.2", "1.9", "10.2", "100.2", "1000.2"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "22", "23", "24", "25", "26", "27", "28", "29", "102", "1002"},
+						Decimals: []string{"0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "10.2", "100.2", "1000.2"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1590,6 +1633,7 @@ var Info = PluralInfo{
 				{Form: "two", Cond: "i10 == 2 && i100 != 12"},
 				{Form: "many", Cond: "(i10 == 7 || i10 == 8) && i100 != 17 && i100 != 18"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: I, Mod: 100},
@@ -1605,8 +1649,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.2", "1.0", "1.2", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1624,7 +1668,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "3", "6", "9", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "3", "4", "5", "6", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1639,6 +1683,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "n == 1"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 100},
 			},
@@ -1650,12 +1695,12 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"0", "2", "16", "102", "1002"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "102", "1002"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"20", "35", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1665,7 +1710,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1681,6 +1726,7 @@ var Info = PluralInfo{
 				{Form: "two", Cond: "n == 2 || n == 3"},
 				{Form: "few", Cond: "n == 4"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -1691,8 +1737,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -1710,7 +1756,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1736,18 +1782,18 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"0", "2", "10", "102", "107", "1002"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "102", "103", "104", "105", "106", "107", "1002"},
 						Decimals: []string{"0.0", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "10.0", "102.0", "1002.0"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"11", "19", "111", "117", "1011"},
+						Integers: []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "111", "112", "113", "114", "115", "116", "117", "1011"},
 						Decimals: []string{"11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"20", "35", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -1761,6 +1807,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "p && n >= 1 && n <= 4"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -1771,18 +1818,18 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"1", "4"},
+						Integers: []string{"1", "2", "3", "4"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1799,6 +1846,7 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "n == 4"},
 				{Form: "many", Cond: "n == 6"},
 			},
+			OrdinalData: true,
 
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
@@ -1809,14 +1857,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"1", "5", "7", "9"},
+						Integers: []string{"1", "5", "7", "8", "9"},
 					},
 					{
 						Expected: "two",
@@ -1832,7 +1880,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "10", "24", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1844,6 +1892,8 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "p && n >= 0 && n <= 1"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
@@ -1853,14 +1903,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1874,6 +1924,7 @@ var Info = PluralInfo{
 				{Form: "many", Cond: "v == 0 && i != 1 && i10 >= 0 && i10 <= 1 || v == 0 && i10 >= 5 && i10 <= 9 || v == 0 && i100 >= 12 && i100 <= 14"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: I, Mod: 100},
@@ -1886,22 +1937,22 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "4", "22", "24", "32", "34", "42", "44", "52", "54", "62", "102", "1002"},
+						Integers: []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 					{
 						Expected: "other",
 
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1913,23 +1964,25 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "i >= 0 && i <= 1"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.5"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"2.0", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1943,6 +1996,7 @@ var Info = PluralInfo{
 				{Form: "many", Cond: "v == 0 && i10 == 0 || v == 0 && i10 >= 5 && i10 <= 9 || v == 0 && i100 >= 11 && i100 <= 14"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 10},
 				{Symbol: I, Mod: 100},
@@ -1955,22 +2009,22 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "4", "22", "24", "32", "34", "42", "44", "52", "54", "62", "102", "1002"},
+						Integers: []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 					{
 						Expected: "other",
 
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -1988,17 +2042,17 @@ var Info = PluralInfo{
 					{
 						Expected: "one",
 						Integers: []string{"0", "1"},
-						Decimals: []string{"0.0", "1.0", "0.00", "0.04"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04"},
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "10"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10"},
 						Decimals: []string{"2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "2.00", "3.00", "4.00", "5.00", "6.00", "7.00", "8.00"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"11", "26", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"1.1", "1.9", "2.1", "2.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -2010,6 +2064,8 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "n == 0 || n == 1 || i == 0 && f == 1"},
 			},
 
+			OrdinalData: true,
+
 			Tests: UnitTests{
 				Cardinal: []UnitTest{
 					{
@@ -2019,14 +2075,14 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "17", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.2", "0.9", "1.1", "1.8", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -2040,6 +2096,7 @@ var Info = PluralInfo{
 				{Form: "few", Cond: "v == 0 && i100 >= 3 && i100 <= 4 || v != 0"},
 			},
 
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: I, Mod: 100},
 			},
@@ -2056,17 +2113,17 @@ var Info = PluralInfo{
 					{
 						Expected: "few",
 						Integers: []string{"3", "4", "103", "104", "203", "204", "303", "304", "403", "404", "503", "504", "603", "604", "703", "704", "1003"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 				Ordinal: []UnitTest{
 					{
 						Expected: "other",
-						Integers: []string{"0", "15", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -2081,6 +2138,7 @@ var Info = PluralInfo{
 				{Form: "one", Cond: "n == 1"},
 				{Form: "many", Cond: "n10 == 4 && n100 != 14"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -2094,8 +2152,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -2109,7 +2167,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "3", "5", "17", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "2", "3", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -2123,6 +2181,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "one", Cond: "(n10 == 1 || n10 == 2) && n100 != 11 && n100 != 12"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -2135,8 +2194,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -2146,7 +2205,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "3", "17", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -2160,6 +2219,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "few", Cond: "n10 == 6 || n10 == 9 || n == 10"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 			},
@@ -2172,8 +2232,8 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "16", "100", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.0", "0.9", "1.1", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -2183,7 +2243,7 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "5", "7", "8", "11", "15", "17", "18", "20", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "3", "4", "5", "7", "8", "11", "12", "13", "14", "15", "17", "18", "20", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
@@ -2199,13 +2259,13 @@ var Info = PluralInfo{
 				Cardinal: []UnitTest{
 					{
 						Expected: "one",
-						Integers: []string{"0", "1", "11", "24"},
+						Integers: []string{"0", "1", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24"},
 						Decimals: []string{"0.0", "1.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "19.0", "20.0", "21.0", "22.0", "23.0", "24.0"},
 					},
 					{
 						Expected: "other",
-						Integers: []string{"2", "10", "100", "106", "1000", "10000", "100000", "1000000"},
-						Decimals: []string{"0.1", "0.9", "1.1", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Integers: []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "100", "101", "102", "103", "104", "105", "106", "1000", "10000", "100000", "1000000"},
+						Decimals: []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 			},
@@ -2221,6 +2281,7 @@ var Info = PluralInfo{
 			Ordinal: Cases{
 				{Form: "few", Cond: "n10 == 3 && n100 != 13"},
 			},
+			OrdinalData: true,
 			Vars: []Var{
 				{Symbol: N, Mod: 10},
 				{Symbol: N, Mod: 100},
@@ -2235,16 +2296,16 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "few",
-						Integers: []string{"2", "4", "22", "24", "32", "34", "42", "44", "52", "54", "62", "102", "1002"},
+						Integers: []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
 					},
 					{
 						Expected: "many",
-						Integers: []string{"0", "5", "19", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
 					},
 					{
 						Expected: "other",
 
-						Decimals: []string{"0.0", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+						Decimals: []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
 					},
 				},
 				Ordinal: []UnitTest{
@@ -2254,11 +2315,15 @@ var Info = PluralInfo{
 					},
 					{
 						Expected: "other",
-						Integers: []string{"0", "2", "4", "16", "100", "1000", "10000", "100000", "1000000"},
+						Integers: []string{"0", "1", "2", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
 					},
 				},
 			},
 		},
 	},
-	Others: []string{"bm", "bo", "dz", "id", "ig", "ii", "in", "ja", "jbo", "jv", "jw", "kde", "kea", "km", "ko", "lkt", "my", "nqo", "osa", "root", "sah", "ses", "sg", "su", "th", "to", "wo", "yo", "yue", "zh"},
+	Others:        []string{"bm", "bo", "dz", "id", "ig", "ii", "in", "ja", "jbo", "jv", "jw", "kde", "kea", "km", "ko", "lkt", "my", "nqo", "osa", "root", "sah", "ses", "sg", "su", "th", "to", "wo", "yo", "yue", "zh"},
+	OrdinalOthers: []string{"id", "in", "ja", "km", "ko", "my", "root", "th", "yue", "zh"},
+	Parents:       map[string]string{"pt-AO": "pt-PT", "pt-CH": "pt-PT", "pt-CV": "pt-PT", "pt-GQ": "pt-PT", "pt-GW": "pt-PT", "pt-LU": "pt-PT", "pt-MO": "pt-PT", "pt-MZ": "pt-PT", "pt-ST": "pt-PT", "pt-TL": "pt-PT"},
+	LikelySubtags: map[string]string{"pt": "pt-Latn-BR", "ro": "ro-Latn-RO", "sr": "sr-Cyrl-RS", "sr-ME": "sr-Latn-ME", "sr-RO": "sr-Latn-RO", "sr-RU": "sr-Latn-RU", "sr-TR": "sr-Latn-TR"},
+	Aliases:       map[string]string{"arb": "ar", "azj": "az", "cmn": "zh", "ekk": "et", "in": "id", "iw": "he", "ji": "yi", "jw": "jv", "khk": "mn", "lvs": "lv", "mo": "ro", "no": "nb", "pes": "fa", "sh": "sr-Latn", "swh": "sw", "tl": "fil", "tw": "ak", "uzn": "uz", "ydd": "yi", "zsm": "ms"},
 }
@@ -0,0 +1,130 @@
+package plural
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// CaseResult is a condition of a culture evaluated on a number.
+type CaseResult struct {
+	Case
+	Match bool
+}
+
+// Explanation tells how the category of a number was chosen.
+type Explanation struct {
+	// Culture is the requested culture, Resolved the one whose rules were
+	// used, found as Info.Find does.
+	Culture  language.Tag
+	Resolved language.Tag
+	// Canonical is Culture with its deprecated or legacy code replaced as
+	// CLDR aliases it, "he" for "iw", Culture when none is.
+	Canonical language.Tag
+
+	Ordinal  bool
+	Operands Operands
+
+	// Cases are the conditions of Resolved in their evaluation order, none
+	// for the Others.
+	Cases []CaseResult
+	// Matched is the index in Cases of the first matching condition, -1
+	// when the category is Other.
+	Matched int
+
+	Category Category
+}
+
+// Explain returns the operands of value, the conditions of the culture with
+// the one that matched and the resulting category, using Default. value is
+// any type GetFunc accepts.
+func Explain(culture language.Tag, value interface{}, ordinal bool) (*Explanation, error) {
+	return Default.Explain(culture, value, ordinal)
+}
+
+// Explain returns the operands of value, the conditions of the culture with
+// the one that matched and the resulting category. Whatever the fallback of
+// r, cultures are resolved as Info.Find does, "en-GB" using the rules of
+// "en". Ordinals having a non-zero fraction are Other whatever the
+// conditions.
+//
+// Functions registered without CLDR rules have no conditions, Matched being
+// -1 whatever their category.
+func (r *Registry) Explain(culture language.Tag, value interface{}, ordinal bool) (*Explanation, error) {
+	fn, on, ok := r.resolve(culture, true)
+	if !ok {
+		return nil, fmt.Errorf("UnknownCulture: `%s`", culture)
+	}
+
+	canonical, _ := r.info.Canonicalize(culture)
+	e := &Explanation{
+		Culture:   culture,
+		Resolved:  on,
+		Canonical: canonical,
+		Ordinal:   ordinal,
+		Operands:  operandsOf(value),
+		Matched:   -1,
+		Category:  Other,
+	}
+	rules := fn.cardinalRules
+	if ordinal {
+		rules = fn.ordinalRules
+	}
+	if nil != rules {
+		e.evaluate(rules.cases, rules.rules, rules.code)
+	}
+	e.Category = fn.eval(e.Operands, ordinal)
+	return e, nil
+}
+
+// evaluate matches the operands against every case, rules holding their
+// compiled conditions in code.
+func (e *Explanation) evaluate(cases Cases, rules []rule, code []instr) {
+	for i, r := range rules {
+		match := e.Operands.match(code[r.start:r.end])
+		e.Cases = append(e.Cases, CaseResult{cases[i], match})
+		if match && -1 == e.Matched && !(e.Ordinal && 0 != e.Operands.W) {
+			e.Matched, e.Category = i, r.form
+		}
+	}
+}
+
+// String writes the explanation on several lines, marking the matched
+// condition.
+func (e *Explanation) String() string {
+	var b strings.Builder
+	kind := "cardinal"
+	if e.Ordinal {
+		kind = "ordinal"
+	}
+	b.WriteString(e.Culture.String())
+	if e.Canonical != e.Culture {
+		fmt.Fprintf(&b, " (%s)", e.Canonical)
+	}
+	fmt.Fprintf(&b, " %s: %s", kind, e.Category)
+	if e.Resolved != e.Culture {
+		fmt.Fprintf(&b, " using %s", e.Resolved)
+	}
+	o := e.Operands
+	fmt.Fprintf(&b, "\n  n=%v i=%d v=%d w=%d f=%d t=%d", o.N, o.I, o.V, o.W, o.F, o.T)
+	if 0 != o.E {
+		fmt.Fprintf(&b, " e=%d", o.E)
+	}
+	if e.Ordinal && 0 != o.W {
+		b.WriteString("\n  ordinals are only defined for integers")
+	}
+	for i, c := range e.Cases {
+		mark := " "
+		if i == e.Matched {
+			mark = "*"
+		} else if c.Match {
+			mark = "."
+		}
+		fmt.Fprintf(&b, "\n%s %s: %s", mark, c.Form, c.Cond)
+	}
+	if -1 == e.Matched {
+		b.WriteString("\n* other")
+	}
+	return b.String()
+}
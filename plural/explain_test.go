@@ -0,0 +1,67 @@
+package plural
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestExplain(t *testing.T) {
+	e, err := Explain(language.MustParse("ru"), 21, false)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if e.Category != One || 0 != e.Matched || e.Cases[e.Matched].Form != "one" || !e.Cases[0].Match {
+		t.Errorf("`ru` 21 expecting the first case <one> but got %+v", e)
+	}
+	if e.Operands != (Operands{N: 21, I: 21}) {
+		t.Errorf("`ru` 21 unexpected operands %+v", e.Operands)
+	}
+
+	e, err = Explain(language.MustParse("en-GB"), "2.5", true)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if e.Resolved != language.English || e.Category != Other || -1 != e.Matched {
+		t.Errorf("`en-GB` ordinal 2.5 expecting <other> using en but got %+v", e)
+	}
+	if 3 != len(e.Cases) {
+		t.Errorf("`en-GB` ordinal 2.5 expecting the 3 ordinal cases of en but got %+v", e.Cases)
+	}
+
+	iw, _ := language.Raw.Parse("iw")
+	e, err = Explain(iw, 2, false)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if he := language.MustParse("he"); e.Canonical != he || e.Resolved != he || e.Category != Two {
+		t.Errorf("`iw` 2 expecting <two> using he but got %+v", e)
+	}
+
+	e, err = Explain(language.Japanese, 1, false)
+	if nil != err || e.Category != Other || 0 != len(e.Cases) {
+		t.Errorf("`ja` 1 expecting <other> without cases but got %+v (%v)", e, err)
+	}
+}
+
+func TestExplainFuncs(t *testing.T) {
+	values := []string{"0", "1", "2", "3", "5", "11", "21", "22", "100", "101", "1000000", "0.0", "1.0", "1.5", "2.10", "0.01"}
+	for _, lang := range Info.Langs() {
+		tag := language.MustParse(lang)
+		fn, err := GetOperandsFunc(tag)
+		if nil != err {
+			t.Fatalf("`%s` unexpected error: %s", lang, err.Error())
+		}
+		for _, value := range values {
+			for _, ordinal := range []bool{false, true} {
+				e, err := Explain(tag, value, ordinal)
+				if nil != err {
+					t.Fatalf("`%s` unexpected error: %s", lang, err.Error())
+				}
+				if expected := fn(e.Operands, ordinal); e.Category != expected {
+					t.Errorf("`%s` %s ordinal=%v expecting <%s> but got <%s>", lang, value, ordinal, expected, e.Category)
+				}
+			}
+		}
+	}
+}
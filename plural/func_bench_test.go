@@ -0,0 +1,15 @@
+//go:build !plural_compact
+// +build !plural_compact
+
+package plural
+
+import "testing"
+
+// BenchmarkLoadPluralFuncs measures the cost of building the registry, which
+// is paid on the first GetFunc call instead of when the package is imported.
+func BenchmarkLoadPluralFuncs(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		loadPluralFuncs()
+	}
+}
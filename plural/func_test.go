@@ -1,11 +1,20 @@
 // Generated by https://github.com/gotnospirit/makeplural
-// at 2020-04-01T16:35:34+08:00
+// at 2026-10-18T19:16:15Z
 //
 // URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json
 //
 //
 // URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json
 //
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/parentLocales.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/likelySubtags.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/aliases.json
+//
 
 package plural
 
@@ -34,6 +43,33 @@ func testNamedKey(t *testing.T, fn func(interface{}, bool) string, input interfa
 	}
 }
 
+func benchmarkOperands(b *testing.B, culture language.Tag, cardinals, ordinals []string) {
+	fn, err := GetOperandsFunc(culture)
+	if nil != err {
+		b.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	run := func() {
+		for _, value := range cardinals {
+			o, _ := ParseOperands(value)
+			fn(o, false)
+		}
+		for _, value := range ordinals {
+			o, _ := ParseOperands(value)
+			fn(o, true)
+		}
+	}
+	if allocs := testing.AllocsPerRun(10, run); allocs != 0 {
+		b.Errorf("expecting no allocation but got %v", allocs)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		run()
+	}
+}
+
 func TestPluralFunc_af(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("af"))
 	if nil != fn {
@@ -44,6 +80,19 @@ func TestPluralFunc_af(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -51,8 +100,20 @@ func TestPluralFunc_af(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -61,6 +122,20 @@ func TestPluralFunc_af(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -84,6 +159,20 @@ func TestPluralFunc_ak(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -91,8 +180,20 @@ func TestPluralFunc_ak(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -100,6 +201,7 @@ func TestPluralFunc_ak(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -109,10 +211,36 @@ func TestPluralFunc_am(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -120,6 +248,20 @@ func TestPluralFunc_am(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -128,6 +270,20 @@ func TestPluralFunc_am(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -147,6 +303,19 @@ func TestPluralFunc_an(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -154,8 +323,20 @@ func TestPluralFunc_an(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -164,6 +345,20 @@ func TestPluralFunc_an(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -187,8 +382,20 @@ func TestPluralFunc_ar(t *testing.T) {
 		testNamedKey(t, fn, "2.000", `two`, `fn("2.000", false)`, false)
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
 		testNamedKey(t, fn, 103, `few`, `fn(103, false)`, false)
+		testNamedKey(t, fn, 104, `few`, `fn(104, false)`, false)
+		testNamedKey(t, fn, 105, `few`, `fn(105, false)`, false)
+		testNamedKey(t, fn, 106, `few`, `fn(106, false)`, false)
+		testNamedKey(t, fn, 107, `few`, `fn(107, false)`, false)
+		testNamedKey(t, fn, 108, `few`, `fn(108, false)`, false)
+		testNamedKey(t, fn, 109, `few`, `fn(109, false)`, false)
 		testNamedKey(t, fn, 110, `few`, `fn(110, false)`, false)
 		testNamedKey(t, fn, 1003, `few`, `fn(1003, false)`, false)
 		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", false)`, false)
@@ -202,6 +409,20 @@ func TestPluralFunc_ar(t *testing.T) {
 		testNamedKey(t, fn, "103.0", `few`, `fn("103.0", false)`, false)
 		testNamedKey(t, fn, "1003.0", `few`, `fn("1003.0", false)`, false)
 		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
+		testNamedKey(t, fn, 20, `many`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `many`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `many`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `many`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `many`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `many`, `fn(25, false)`, false)
 		testNamedKey(t, fn, 26, `many`, `fn(26, false)`, false)
 		testNamedKey(t, fn, 111, `many`, `fn(111, false)`, false)
 		testNamedKey(t, fn, 1011, `many`, `fn(1011, false)`, false)
@@ -221,14 +442,19 @@ func TestPluralFunc_ar(t *testing.T) {
 		testNamedKey(t, fn, "0.000", `zero`, `fn("0.000", false)`, false)
 		testNamedKey(t, fn, "0.0000", `zero`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
+		testNamedKey(t, fn, 101, `other`, `fn(101, false)`, false)
 		testNamedKey(t, fn, 102, `other`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 200, `other`, `fn(200, false)`, false)
+		testNamedKey(t, fn, 201, `other`, `fn(201, false)`, false)
 		testNamedKey(t, fn, 202, `other`, `fn(202, false)`, false)
 		testNamedKey(t, fn, 300, `other`, `fn(300, false)`, false)
+		testNamedKey(t, fn, 301, `other`, `fn(301, false)`, false)
 		testNamedKey(t, fn, 302, `other`, `fn(302, false)`, false)
 		testNamedKey(t, fn, 400, `other`, `fn(400, false)`, false)
+		testNamedKey(t, fn, 401, `other`, `fn(401, false)`, false)
 		testNamedKey(t, fn, 402, `other`, `fn(402, false)`, false)
 		testNamedKey(t, fn, 500, `other`, `fn(500, false)`, false)
+		testNamedKey(t, fn, 501, `other`, `fn(501, false)`, false)
 		testNamedKey(t, fn, 502, `other`, `fn(502, false)`, false)
 		testNamedKey(t, fn, 600, `other`, `fn(600, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -236,8 +462,20 @@ func TestPluralFunc_ar(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -246,6 +484,20 @@ func TestPluralFunc_ar(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -269,8 +521,20 @@ func TestPluralFunc_ars(t *testing.T) {
 		testNamedKey(t, fn, "2.000", `two`, `fn("2.000", false)`, false)
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
 		testNamedKey(t, fn, 103, `few`, `fn(103, false)`, false)
+		testNamedKey(t, fn, 104, `few`, `fn(104, false)`, false)
+		testNamedKey(t, fn, 105, `few`, `fn(105, false)`, false)
+		testNamedKey(t, fn, 106, `few`, `fn(106, false)`, false)
+		testNamedKey(t, fn, 107, `few`, `fn(107, false)`, false)
+		testNamedKey(t, fn, 108, `few`, `fn(108, false)`, false)
+		testNamedKey(t, fn, 109, `few`, `fn(109, false)`, false)
 		testNamedKey(t, fn, 110, `few`, `fn(110, false)`, false)
 		testNamedKey(t, fn, 1003, `few`, `fn(1003, false)`, false)
 		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", false)`, false)
@@ -284,6 +548,20 @@ func TestPluralFunc_ars(t *testing.T) {
 		testNamedKey(t, fn, "103.0", `few`, `fn("103.0", false)`, false)
 		testNamedKey(t, fn, "1003.0", `few`, `fn("1003.0", false)`, false)
 		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
+		testNamedKey(t, fn, 20, `many`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `many`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `many`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `many`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `many`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `many`, `fn(25, false)`, false)
 		testNamedKey(t, fn, 26, `many`, `fn(26, false)`, false)
 		testNamedKey(t, fn, 111, `many`, `fn(111, false)`, false)
 		testNamedKey(t, fn, 1011, `many`, `fn(1011, false)`, false)
@@ -303,14 +581,19 @@ func TestPluralFunc_ars(t *testing.T) {
 		testNamedKey(t, fn, "0.000", `zero`, `fn("0.000", false)`, false)
 		testNamedKey(t, fn, "0.0000", `zero`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
+		testNamedKey(t, fn, 101, `other`, `fn(101, false)`, false)
 		testNamedKey(t, fn, 102, `other`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 200, `other`, `fn(200, false)`, false)
+		testNamedKey(t, fn, 201, `other`, `fn(201, false)`, false)
 		testNamedKey(t, fn, 202, `other`, `fn(202, false)`, false)
 		testNamedKey(t, fn, 300, `other`, `fn(300, false)`, false)
+		testNamedKey(t, fn, 301, `other`, `fn(301, false)`, false)
 		testNamedKey(t, fn, 302, `other`, `fn(302, false)`, false)
 		testNamedKey(t, fn, 400, `other`, `fn(400, false)`, false)
+		testNamedKey(t, fn, 401, `other`, `fn(401, false)`, false)
 		testNamedKey(t, fn, 402, `other`, `fn(402, false)`, false)
 		testNamedKey(t, fn, 500, `other`, `fn(500, false)`, false)
+		testNamedKey(t, fn, 501, `other`, `fn(501, false)`, false)
 		testNamedKey(t, fn, 502, `other`, `fn(502, false)`, false)
 		testNamedKey(t, fn, 600, `other`, `fn(600, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -318,8 +601,20 @@ func TestPluralFunc_ars(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -327,6 +622,11 @@ func TestPluralFunc_ars(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -336,10 +636,36 @@ func TestPluralFunc_as(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -347,6 +673,20 @@ func TestPluralFunc_as(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -357,6 +697,8 @@ func TestPluralFunc_as(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 5, `one`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `one`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `one`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `one`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `one`, `fn(10, true)`, true)
 		testNamedKey(t, fn, 2, `two`, `fn(2, true)`, true)
 		testNamedKey(t, fn, 3, `two`, `fn(3, true)`, true)
@@ -364,12 +706,35 @@ func TestPluralFunc_as(t *testing.T) {
 		testNamedKey(t, fn, 6, `many`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
+		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
+		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
+		testNamedKey(t, fn, 22, `other`, `fn(22, true)`, true)
+		testNamedKey(t, fn, 23, `other`, `fn(23, true)`, true)
+		testNamedKey(t, fn, 24, `other`, `fn(24, true)`, true)
 		testNamedKey(t, fn, 25, `other`, `fn(25, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "6.0", `many`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -383,6 +748,19 @@ func TestPluralFunc_asa(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -390,15 +768,28 @@ func TestPluralFunc_asa(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
-		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
-		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
-		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
-		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
 		testNamedKey(t, fn, "1000.0", `other`, `fn("1000.0", false)`, false)
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -408,6 +799,19 @@ func TestPluralFunc_ast(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -415,6 +819,20 @@ func TestPluralFunc_ast(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -422,6 +840,7 @@ func TestPluralFunc_ast(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -435,6 +854,19 @@ func TestPluralFunc_az(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -442,8 +874,20 @@ func TestPluralFunc_az(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -462,6 +906,7 @@ func TestPluralFunc_az(t *testing.T) {
 		testNamedKey(t, fn, 17, `one`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 18, `one`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 20, `one`, `fn(20, true)`, true)
+		testNamedKey(t, fn, 21, `one`, `fn(21, true)`, true)
 		testNamedKey(t, fn, 22, `one`, `fn(22, true)`, true)
 		testNamedKey(t, fn, 25, `one`, `fn(25, true)`, true)
 		testNamedKey(t, fn, 101, `one`, `fn(101, true)`, true)
@@ -509,6 +954,14 @@ func TestPluralFunc_az(t *testing.T) {
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", true)`, true)
+		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `many`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
+		testNamedKey(t, fn, "9.0", `other`, `fn("9.0", true)`, true)
+		testNamedKey(t, fn, "9.5", `other`, `fn("9.5", true)`, true)
 	}
 }
 
@@ -536,14 +989,19 @@ func TestPluralFunc_be(t *testing.T) {
 		testNamedKey(t, fn, "101.0", `one`, `fn("101.0", false)`, false)
 		testNamedKey(t, fn, "1001.0", `one`, `fn("1001.0", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
@@ -560,6 +1018,19 @@ func TestPluralFunc_be(t *testing.T) {
 		testNamedKey(t, fn, "1002.0", `few`, `fn("1002.0", false)`, false)
 		testNamedKey(t, fn, 0, `many`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `many`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `many`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `many`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `many`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `many`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `many`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `many`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `many`, `fn(1000, false)`, false)
@@ -580,8 +1051,20 @@ func TestPluralFunc_be(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `many`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `many`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.1", `other`, `fn("100.1", false)`, false)
@@ -607,12 +1090,28 @@ func TestPluralFunc_be(t *testing.T) {
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "2.0", `few`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -626,6 +1125,19 @@ func TestPluralFunc_bem(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -633,8 +1145,20 @@ func TestPluralFunc_bem(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -642,6 +1166,7 @@ func TestPluralFunc_bem(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -655,6 +1180,19 @@ func TestPluralFunc_bez(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -662,8 +1200,20 @@ func TestPluralFunc_bez(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -671,6 +1221,7 @@ func TestPluralFunc_bez(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -684,6 +1235,19 @@ func TestPluralFunc_bg(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -691,8 +1255,20 @@ func TestPluralFunc_bg(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -701,6 +1277,20 @@ func TestPluralFunc_bg(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -724,6 +1314,20 @@ func TestPluralFunc_bho(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -731,8 +1335,20 @@ func TestPluralFunc_bho(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -740,6 +1356,7 @@ func TestPluralFunc_bho(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -747,6 +1364,20 @@ func TestPluralFunc_bm(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("bm"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -754,6 +1385,20 @@ func TestPluralFunc_bm(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -770,10 +1415,36 @@ func TestPluralFunc_bn(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -781,6 +1452,20 @@ func TestPluralFunc_bn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -791,6 +1476,8 @@ func TestPluralFunc_bn(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 5, `one`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `one`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `one`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `one`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `one`, `fn(10, true)`, true)
 		testNamedKey(t, fn, 2, `two`, `fn(2, true)`, true)
 		testNamedKey(t, fn, 3, `two`, `fn(3, true)`, true)
@@ -798,12 +1485,35 @@ func TestPluralFunc_bn(t *testing.T) {
 		testNamedKey(t, fn, 6, `many`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
+		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
+		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
+		testNamedKey(t, fn, 22, `other`, `fn(22, true)`, true)
+		testNamedKey(t, fn, 23, `other`, `fn(23, true)`, true)
+		testNamedKey(t, fn, 24, `other`, `fn(24, true)`, true)
 		testNamedKey(t, fn, 25, `other`, `fn(25, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "6.0", `many`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -811,17 +1521,45 @@ func TestPluralFunc_bo(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("bo"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
-		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
-		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
-		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
-		testNamedKey(t, fn, 10000, `other`, `fn(10000, false)`, false)
-		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
-		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
-		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
-		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
-		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
-		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
-		testNamedKey(t, fn, "1000.0", `other`, `fn("1000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
+		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
+		testNamedKey(t, fn, 10000, `other`, `fn(10000, false)`, false)
+		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
+		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
+		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
+		testNamedKey(t, fn, "1000.0", `other`, `fn("1000.0", false)`, false)
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
@@ -897,22 +1635,49 @@ func TestPluralFunc_br(t *testing.T) {
 		testNamedKey(t, fn, "1000000.000", `many`, `fn("1000000.000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
 		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
 		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, false)`, false)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
 		testNamedKey(t, fn, "1000.0", `other`, `fn("1000.0", false)`, false)
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
 	}
 }
 
@@ -926,6 +1691,19 @@ func TestPluralFunc_brx(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -933,8 +1711,20 @@ func TestPluralFunc_brx(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -942,6 +1732,7 @@ func TestPluralFunc_brx(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -970,27 +1761,37 @@ func TestPluralFunc_bs(t *testing.T) {
 		testNamedKey(t, fn, "100.1", `one`, `fn("100.1", false)`, false)
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
 		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
 		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
 		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "2.2", `few`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `few`, `fn("2.3", false)`, false)
 		testNamedKey(t, fn, "2.4", `few`, `fn("2.4", false)`, false)
 		testNamedKey(t, fn, "3.2", `few`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `few`, `fn("3.3", false)`, false)
 		testNamedKey(t, fn, "3.4", `few`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "4.2", `few`, `fn("4.2", false)`, false)
+		testNamedKey(t, fn, "4.3", `few`, `fn("4.3", false)`, false)
 		testNamedKey(t, fn, "4.4", `few`, `fn("4.4", false)`, false)
 		testNamedKey(t, fn, "5.2", `few`, `fn("5.2", false)`, false)
 		testNamedKey(t, fn, "10.2", `few`, `fn("10.2", false)`, false)
@@ -998,6 +1799,19 @@ func TestPluralFunc_bs(t *testing.T) {
 		testNamedKey(t, fn, "1000.2", `few`, `fn("1000.2", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1006,10 +1820,19 @@ func TestPluralFunc_bs(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1018,6 +1841,20 @@ func TestPluralFunc_bs(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1033,6 +1870,19 @@ func TestPluralFunc_ca(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1040,6 +1890,20 @@ func TestPluralFunc_ca(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1053,12 +1917,33 @@ func TestPluralFunc_ca(t *testing.T) {
 		testNamedKey(t, fn, 4, `few`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -1072,6 +1957,19 @@ func TestPluralFunc_ce(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1079,8 +1977,20 @@ func TestPluralFunc_ce(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1089,6 +1999,20 @@ func TestPluralFunc_ce(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1102,11 +2026,15 @@ func TestPluralFunc_ceb(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ceb"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `one`, `fn(2, false)`, false)
 		testNamedKey(t, fn, 3, `one`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 5, `one`, `fn(5, false)`, false)
 		testNamedKey(t, fn, 7, `one`, `fn(7, false)`, false)
 		testNamedKey(t, fn, 8, `one`, `fn(8, false)`, false)
 		testNamedKey(t, fn, 10, `one`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `one`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `one`, `fn(12, false)`, false)
 		testNamedKey(t, fn, 13, `one`, `fn(13, false)`, false)
 		testNamedKey(t, fn, 15, `one`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 17, `one`, `fn(17, false)`, false)
@@ -1119,11 +2047,15 @@ func TestPluralFunc_ceb(t *testing.T) {
 		testNamedKey(t, fn, 100000, `one`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `one`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
 		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
 		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
 		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
 		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
 		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.7", `one`, `fn("1.7", false)`, false)
@@ -1157,6 +2089,7 @@ func TestPluralFunc_ceb(t *testing.T) {
 		testNamedKey(t, fn, "10.4", `other`, `fn("10.4", false)`, false)
 		testNamedKey(t, fn, "100.4", `other`, `fn("100.4", false)`, false)
 		testNamedKey(t, fn, "1000.4", `other`, `fn("1000.4", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -1170,6 +2103,19 @@ func TestPluralFunc_cgg(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1177,8 +2123,20 @@ func TestPluralFunc_cgg(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1186,6 +2144,7 @@ func TestPluralFunc_cgg(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -1199,6 +2158,19 @@ func TestPluralFunc_chr(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1206,8 +2178,20 @@ func TestPluralFunc_chr(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1215,6 +2199,7 @@ func TestPluralFunc_chr(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -1228,6 +2213,19 @@ func TestPluralFunc_ckb(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1235,8 +2233,20 @@ func TestPluralFunc_ckb(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1244,6 +2254,7 @@ func TestPluralFunc_ckb(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -1252,8 +2263,23 @@ func TestPluralFunc_cs(t *testing.T) {
 	if nil != fn {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, "0.0", `many`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `many`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `many`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `many`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `many`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `many`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `many`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `many`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `many`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `many`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `many`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `many`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `many`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `many`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `many`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `many`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `many`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `many`, `fn("100.0", false)`, false)
@@ -1263,13 +2289,40 @@ func TestPluralFunc_cs(t *testing.T) {
 		testNamedKey(t, fn, "1000000.0", `many`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
-		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
-		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
+		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, false)`, false)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1310,6 +2363,18 @@ func TestPluralFunc_cy(t *testing.T) {
 		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
 		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1317,8 +2382,20 @@ func TestPluralFunc_cy(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1334,14 +2411,41 @@ func TestPluralFunc_cy(t *testing.T) {
 		testNamedKey(t, fn, 6, `many`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 0, `zero`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 7, `zero`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `zero`, `fn(8, true)`, true)
 		testNamedKey(t, fn, 9, `zero`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
+		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
+		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
+		testNamedKey(t, fn, 22, `other`, `fn(22, true)`, true)
+		testNamedKey(t, fn, 23, `other`, `fn(23, true)`, true)
+		testNamedKey(t, fn, 24, `other`, `fn(24, true)`, true)
 		testNamedKey(t, fn, 25, `other`, `fn(25, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", true)`, true)
+		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", true)`, true)
+		testNamedKey(t, fn, "5.0", `many`, `fn("5.0", true)`, true)
+		testNamedKey(t, fn, "5.5", `other`, `fn("5.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `zero`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
+		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", true)`, true)
+		testNamedKey(t, fn, "10.5", `other`, `fn("10.5", true)`, true)
 	}
 }
 
@@ -1350,9 +2454,36 @@ func TestPluralFunc_da(t *testing.T) {
 	if nil != fn {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `one`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1361,6 +2492,19 @@ func TestPluralFunc_da(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
 		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1369,6 +2513,20 @@ func TestPluralFunc_da(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1384,6 +2542,19 @@ func TestPluralFunc_de(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1391,6 +2562,20 @@ func TestPluralFunc_de(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1399,6 +2584,20 @@ func TestPluralFunc_de(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1489,6 +2688,19 @@ func TestPluralFunc_dsb(t *testing.T) {
 		testNamedKey(t, fn, "1000.3", `few`, `fn("1000.3", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1497,10 +2709,19 @@ func TestPluralFunc_dsb(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1509,6 +2730,20 @@ func TestPluralFunc_dsb(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1528,6 +2763,19 @@ func TestPluralFunc_dv(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1535,8 +2783,20 @@ func TestPluralFunc_dv(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1544,6 +2804,7 @@ func TestPluralFunc_dv(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -1551,6 +2812,20 @@ func TestPluralFunc_dz(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("dz"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1558,6 +2833,20 @@ func TestPluralFunc_dz(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1578,6 +2867,19 @@ func TestPluralFunc_ee(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1585,8 +2887,20 @@ func TestPluralFunc_ee(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1594,6 +2908,7 @@ func TestPluralFunc_ee(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -1607,6 +2922,19 @@ func TestPluralFunc_el(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1614,8 +2942,20 @@ func TestPluralFunc_el(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1624,21 +2964,48 @@ func TestPluralFunc_el(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
-		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
-		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
-		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
-		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
-		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
-		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
-	}
-}
-
-func TestPluralFunc_en(t *testing.T) {
-	fn := getPluralFunc(t, language.MustParse("en"))
-	if nil != fn {
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
+		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
+		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
+		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
+		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+	}
+}
+
+func TestPluralFunc_en(t *testing.T) {
+	fn := getPluralFunc(t, language.MustParse("en"))
+	if nil != fn {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1646,6 +3013,20 @@ func TestPluralFunc_en(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1685,12 +3066,33 @@ func TestPluralFunc_en(t *testing.T) {
 		testNamedKey(t, fn, 1003, `few`, `fn(1003, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", true)`, true)
+		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -1704,6 +3106,19 @@ func TestPluralFunc_eo(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1711,8 +3126,20 @@ func TestPluralFunc_eo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1720,6 +3147,7 @@ func TestPluralFunc_eo(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -1733,6 +3161,19 @@ func TestPluralFunc_es(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1740,8 +3181,20 @@ func TestPluralFunc_es(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1750,6 +3203,20 @@ func TestPluralFunc_es(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1765,6 +3232,19 @@ func TestPluralFunc_et(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1772,6 +3252,20 @@ func TestPluralFunc_et(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1780,6 +3274,20 @@ func TestPluralFunc_et(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1799,6 +3307,19 @@ func TestPluralFunc_eu(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1806,8 +3327,20 @@ func TestPluralFunc_eu(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1816,6 +3349,20 @@ func TestPluralFunc_eu(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1831,10 +3378,36 @@ func TestPluralFunc_fa(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1842,6 +3415,20 @@ func TestPluralFunc_fa(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1850,6 +3437,20 @@ func TestPluralFunc_fa(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1865,8 +3466,36 @@ func TestPluralFunc_ff(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1874,6 +3503,20 @@ func TestPluralFunc_ff(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
+		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1881,6 +3524,7 @@ func TestPluralFunc_ff(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -1890,6 +3534,19 @@ func TestPluralFunc_fi(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -1897,6 +3554,20 @@ func TestPluralFunc_fi(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -1905,6 +3576,20 @@ func TestPluralFunc_fi(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -1918,11 +3603,15 @@ func TestPluralFunc_fil(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("fil"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `one`, `fn(2, false)`, false)
 		testNamedKey(t, fn, 3, `one`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 5, `one`, `fn(5, false)`, false)
 		testNamedKey(t, fn, 7, `one`, `fn(7, false)`, false)
 		testNamedKey(t, fn, 8, `one`, `fn(8, false)`, false)
 		testNamedKey(t, fn, 10, `one`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `one`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `one`, `fn(12, false)`, false)
 		testNamedKey(t, fn, 13, `one`, `fn(13, false)`, false)
 		testNamedKey(t, fn, 15, `one`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 17, `one`, `fn(17, false)`, false)
@@ -1935,11 +3624,15 @@ func TestPluralFunc_fil(t *testing.T) {
 		testNamedKey(t, fn, 100000, `one`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `one`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
 		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
 		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
 		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
 		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
 		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.7", `one`, `fn("1.7", false)`, false)
@@ -1976,12 +3669,29 @@ func TestPluralFunc_fil(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -1995,6 +3705,19 @@ func TestPluralFunc_fo(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2002,8 +3725,20 @@ func TestPluralFunc_fo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2011,6 +3746,7 @@ func TestPluralFunc_fo(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -2020,8 +3756,36 @@ func TestPluralFunc_fr(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2029,6 +3793,20 @@ func TestPluralFunc_fr(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
+		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2039,12 +3817,29 @@ func TestPluralFunc_fr(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2058,6 +3853,19 @@ func TestPluralFunc_fur(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2065,8 +3873,20 @@ func TestPluralFunc_fur(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2074,6 +3894,7 @@ func TestPluralFunc_fur(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -2083,6 +3904,19 @@ func TestPluralFunc_fy(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2090,6 +3924,20 @@ func TestPluralFunc_fy(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2098,6 +3946,20 @@ func TestPluralFunc_fy(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2121,6 +3983,8 @@ func TestPluralFunc_ga(t *testing.T) {
 		testNamedKey(t, fn, "2.000", `two`, `fn("2.000", false)`, false)
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
 		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
 		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", false)`, false)
 		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", false)`, false)
@@ -2139,6 +4003,8 @@ func TestPluralFunc_ga(t *testing.T) {
 		testNamedKey(t, fn, "5.0000", `few`, `fn("5.0000", false)`, false)
 		testNamedKey(t, fn, "6.0000", `few`, `fn("6.0000", false)`, false)
 		testNamedKey(t, fn, 7, `many`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `many`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `many`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `many`, `fn(10, false)`, false)
 		testNamedKey(t, fn, "7.0", `many`, `fn("7.0", false)`, false)
 		testNamedKey(t, fn, "8.0", `many`, `fn("8.0", false)`, false)
@@ -2158,6 +4024,19 @@ func TestPluralFunc_ga(t *testing.T) {
 		testNamedKey(t, fn, "10.0000", `many`, `fn("10.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
+		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `other`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2165,8 +4044,20 @@ func TestPluralFunc_ga(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2177,12 +4068,29 @@ func TestPluralFunc_ga(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2208,8 +4116,19 @@ func TestPluralFunc_gd(t *testing.T) {
 		testNamedKey(t, fn, "12.000", `two`, `fn("12.000", false)`, false)
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
 		testNamedKey(t, fn, 13, `few`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `few`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `few`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `few`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `few`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `few`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `few`, `fn(19, false)`, false)
 		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", false)`, false)
 		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", false)`, false)
@@ -2229,6 +4148,19 @@ func TestPluralFunc_gd(t *testing.T) {
 		testNamedKey(t, fn, "3.00", `few`, `fn("3.00", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `other`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `other`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `other`, `fn(28, false)`, false)
+		testNamedKey(t, fn, 29, `other`, `fn(29, false)`, false)
+		testNamedKey(t, fn, 30, `other`, `fn(30, false)`, false)
+		testNamedKey(t, fn, 31, `other`, `fn(31, false)`, false)
+		testNamedKey(t, fn, 32, `other`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `other`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `other`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2236,8 +4168,20 @@ func TestPluralFunc_gd(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2253,14 +4197,33 @@ func TestPluralFunc_gd(t *testing.T) {
 		testNamedKey(t, fn, 13, `few`, `fn(13, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
 		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
+		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
 		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", true)`, true)
+		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2270,6 +4233,19 @@ func TestPluralFunc_gl(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2277,6 +4253,20 @@ func TestPluralFunc_gl(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2285,6 +4275,20 @@ func TestPluralFunc_gl(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2304,6 +4308,19 @@ func TestPluralFunc_gsw(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2311,8 +4328,20 @@ func TestPluralFunc_gsw(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2321,6 +4350,20 @@ func TestPluralFunc_gsw(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2336,10 +4379,36 @@ func TestPluralFunc_gu(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2347,6 +4416,20 @@ func TestPluralFunc_gu(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2362,12 +4445,34 @@ func TestPluralFunc_gu(t *testing.T) {
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "6.0", `many`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2385,6 +4490,20 @@ func TestPluralFunc_guw(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2392,8 +4511,20 @@ func TestPluralFunc_guw(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2401,6 +4532,7 @@ func TestPluralFunc_guw(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -2440,6 +4572,20 @@ func TestPluralFunc_gv(t *testing.T) {
 		testNamedKey(t, fn, 100000, `few`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `few`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `many`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `many`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `many`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `many`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `many`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `many`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `many`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `many`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `many`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `many`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `many`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `many`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `many`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `many`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `many`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `many`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `many`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `many`, `fn("100.0", false)`, false)
@@ -2448,12 +4594,26 @@ func TestPluralFunc_gv(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `many`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `many`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
 		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 103, `other`, `fn(103, false)`, false)
 		testNamedKey(t, fn, 1003, `other`, `fn(1003, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -2467,6 +4627,19 @@ func TestPluralFunc_ha(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2474,8 +4647,20 @@ func TestPluralFunc_ha(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2483,6 +4668,7 @@ func TestPluralFunc_ha(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -2496,6 +4682,19 @@ func TestPluralFunc_haw(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2503,8 +4702,20 @@ func TestPluralFunc_haw(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2512,6 +4723,7 @@ func TestPluralFunc_haw(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -2535,10 +4747,37 @@ func TestPluralFunc_he(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `many`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 101, `other`, `fn(101, false)`, false)
 		testNamedKey(t, fn, 1001, `other`, `fn(1001, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2547,6 +4786,20 @@ func TestPluralFunc_he(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2562,10 +4815,36 @@ func TestPluralFunc_hi(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2573,6 +4852,20 @@ func TestPluralFunc_hi(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2588,12 +4881,34 @@ func TestPluralFunc_hi(t *testing.T) {
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "6.0", `many`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2622,27 +4937,37 @@ func TestPluralFunc_hr(t *testing.T) {
 		testNamedKey(t, fn, "100.1", `one`, `fn("100.1", false)`, false)
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
 		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
 		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
 		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "2.2", `few`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `few`, `fn("2.3", false)`, false)
 		testNamedKey(t, fn, "2.4", `few`, `fn("2.4", false)`, false)
 		testNamedKey(t, fn, "3.2", `few`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `few`, `fn("3.3", false)`, false)
 		testNamedKey(t, fn, "3.4", `few`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "4.2", `few`, `fn("4.2", false)`, false)
+		testNamedKey(t, fn, "4.3", `few`, `fn("4.3", false)`, false)
 		testNamedKey(t, fn, "4.4", `few`, `fn("4.4", false)`, false)
 		testNamedKey(t, fn, "5.2", `few`, `fn("5.2", false)`, false)
 		testNamedKey(t, fn, "10.2", `few`, `fn("10.2", false)`, false)
@@ -2650,6 +4975,19 @@ func TestPluralFunc_hr(t *testing.T) {
 		testNamedKey(t, fn, "1000.2", `few`, `fn("1000.2", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2658,10 +4996,19 @@ func TestPluralFunc_hr(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2670,6 +5017,20 @@ func TestPluralFunc_hr(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2760,6 +5121,19 @@ func TestPluralFunc_hsb(t *testing.T) {
 		testNamedKey(t, fn, "1000.3", `few`, `fn("1000.3", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2768,10 +5142,19 @@ func TestPluralFunc_hsb(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2780,6 +5163,20 @@ func TestPluralFunc_hsb(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2799,6 +5196,19 @@ func TestPluralFunc_hu(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2806,8 +5216,20 @@ func TestPluralFunc_hu(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2819,14 +5241,29 @@ func TestPluralFunc_hu(t *testing.T) {
 		testNamedKey(t, fn, 5, `one`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
 		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2836,8 +5273,36 @@ func TestPluralFunc_hy(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2845,6 +5310,20 @@ func TestPluralFunc_hy(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
+		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2855,12 +5334,29 @@ func TestPluralFunc_hy(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -2870,6 +5366,19 @@ func TestPluralFunc_ia(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2877,6 +5386,20 @@ func TestPluralFunc_ia(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2885,6 +5408,20 @@ func TestPluralFunc_ia(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2898,6 +5435,20 @@ func TestPluralFunc_id(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("id"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2905,6 +5456,20 @@ func TestPluralFunc_id(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2913,6 +5478,20 @@ func TestPluralFunc_id(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -2926,6 +5505,20 @@ func TestPluralFunc_ig(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ig"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2933,6 +5526,20 @@ func TestPluralFunc_ig(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2947,6 +5554,20 @@ func TestPluralFunc_ii(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ii"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2954,6 +5575,20 @@ func TestPluralFunc_ii(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2970,6 +5605,19 @@ func TestPluralFunc_io(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -2977,6 +5625,20 @@ func TestPluralFunc_io(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -2984,6 +5646,7 @@ func TestPluralFunc_io(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3001,12 +5664,39 @@ func TestPluralFunc_is(t *testing.T) {
 		testNamedKey(t, fn, 101, `one`, `fn(101, false)`, false)
 		testNamedKey(t, fn, 1001, `one`, `fn(1001, false)`, false)
 		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `one`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.1", `one`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.1", `one`, `fn("100.1", false)`, false)
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3028,6 +5718,20 @@ func TestPluralFunc_is(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -3043,6 +5747,19 @@ func TestPluralFunc_it(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3050,6 +5767,20 @@ func TestPluralFunc_it(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3062,16 +5793,30 @@ func TestPluralFunc_it(t *testing.T) {
 		testNamedKey(t, fn, 80, `many`, `fn(80, true)`, true)
 		testNamedKey(t, fn, 800, `many`, `fn(800, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
 		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
 		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "8.0", `many`, `fn("8.0", true)`, true)
+		testNamedKey(t, fn, "8.5", `other`, `fn("8.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -3090,6 +5835,19 @@ func TestPluralFunc_iu(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3097,8 +5855,20 @@ func TestPluralFunc_iu(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3106,6 +5876,8 @@ func TestPluralFunc_iu(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -3113,6 +5885,20 @@ func TestPluralFunc_ja(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ja"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3120,6 +5906,20 @@ func TestPluralFunc_ja(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3128,6 +5928,20 @@ func TestPluralFunc_ja(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -3141,6 +5955,20 @@ func TestPluralFunc_jbo(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("jbo"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3148,6 +5976,20 @@ func TestPluralFunc_jbo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3168,6 +6010,19 @@ func TestPluralFunc_jgo(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3175,8 +6030,20 @@ func TestPluralFunc_jgo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3184,6 +6051,7 @@ func TestPluralFunc_jgo(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3193,6 +6061,19 @@ func TestPluralFunc_yi(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3200,6 +6081,20 @@ func TestPluralFunc_yi(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3207,6 +6102,7 @@ func TestPluralFunc_yi(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3220,6 +6116,19 @@ func TestPluralFunc_jmc(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3227,8 +6136,20 @@ func TestPluralFunc_jmc(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3236,6 +6157,7 @@ func TestPluralFunc_jmc(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3243,6 +6165,20 @@ func TestPluralFunc_jv(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("jv"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3250,6 +6186,20 @@ func TestPluralFunc_jv(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3270,6 +6220,19 @@ func TestPluralFunc_ka(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3277,8 +6240,20 @@ func TestPluralFunc_ka(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3289,16 +6264,49 @@ func TestPluralFunc_ka(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `many`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `many`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `many`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `many`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `many`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `many`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `many`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `many`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `many`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `many`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `many`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `many`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `many`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `many`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `many`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `many`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 102, `many`, `fn(102, true)`, true)
 		testNamedKey(t, fn, 1002, `many`, `fn(1002, true)`, true)
 		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
+		testNamedKey(t, fn, 22, `other`, `fn(22, true)`, true)
+		testNamedKey(t, fn, 23, `other`, `fn(23, true)`, true)
+		testNamedKey(t, fn, 24, `other`, `fn(24, true)`, true)
+		testNamedKey(t, fn, 25, `other`, `fn(25, true)`, true)
+		testNamedKey(t, fn, 26, `other`, `fn(26, true)`, true)
+		testNamedKey(t, fn, 27, `other`, `fn(27, true)`, true)
+		testNamedKey(t, fn, 28, `other`, `fn(28, true)`, true)
+		testNamedKey(t, fn, 29, `other`, `fn(29, true)`, true)
+		testNamedKey(t, fn, 30, `other`, `fn(30, true)`, true)
+		testNamedKey(t, fn, 31, `other`, `fn(31, true)`, true)
+		testNamedKey(t, fn, 32, `other`, `fn(32, true)`, true)
+		testNamedKey(t, fn, 33, `other`, `fn(33, true)`, true)
+		testNamedKey(t, fn, 34, `other`, `fn(34, true)`, true)
+		testNamedKey(t, fn, 35, `other`, `fn(35, true)`, true)
 		testNamedKey(t, fn, 36, `other`, `fn(36, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `many`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
+		testNamedKey(t, fn, "21.0", `other`, `fn("21.0", true)`, true)
+		testNamedKey(t, fn, "21.5", `other`, `fn("21.5", true)`, true)
 	}
 }
 
@@ -3308,8 +6316,36 @@ func TestPluralFunc_kab(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3317,6 +6353,20 @@ func TestPluralFunc_kab(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
+		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3324,6 +6374,7 @@ func TestPluralFunc_kab(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -3337,6 +6388,19 @@ func TestPluralFunc_kaj(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3344,8 +6408,20 @@ func TestPluralFunc_kaj(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3353,6 +6429,7 @@ func TestPluralFunc_kaj(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3366,6 +6443,19 @@ func TestPluralFunc_kcg(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3373,8 +6463,20 @@ func TestPluralFunc_kcg(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3382,6 +6484,7 @@ func TestPluralFunc_kcg(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3389,6 +6492,20 @@ func TestPluralFunc_kde(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("kde"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3396,6 +6513,20 @@ func TestPluralFunc_kde(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3410,6 +6541,20 @@ func TestPluralFunc_kea(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("kea"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3417,6 +6562,20 @@ func TestPluralFunc_kea(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3437,6 +6596,19 @@ func TestPluralFunc_kk(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3444,8 +6616,20 @@ func TestPluralFunc_kk(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3471,16 +6655,27 @@ func TestPluralFunc_kk(t *testing.T) {
 		testNamedKey(t, fn, 100000, `many`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `many`, `fn(1000000, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
 		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
 		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
 		testNamedKey(t, fn, 101, `other`, `fn(101, true)`, true)
 		testNamedKey(t, fn, 1001, `other`, `fn(1001, true)`, true)
+		testNamedKey(t, fn, "6.0", `many`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -3494,6 +6689,19 @@ func TestPluralFunc_kkj(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3501,8 +6709,20 @@ func TestPluralFunc_kkj(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3510,6 +6730,7 @@ func TestPluralFunc_kkj(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3523,6 +6744,19 @@ func TestPluralFunc_kl(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3530,8 +6764,20 @@ func TestPluralFunc_kl(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3539,6 +6785,7 @@ func TestPluralFunc_kl(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3546,6 +6793,20 @@ func TestPluralFunc_km(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("km"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3553,6 +6814,20 @@ func TestPluralFunc_km(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3561,6 +6836,20 @@ func TestPluralFunc_km(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -3576,10 +6865,36 @@ func TestPluralFunc_kn(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3587,6 +6902,20 @@ func TestPluralFunc_kn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3595,6 +6924,20 @@ func TestPluralFunc_kn(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -3608,6 +6951,20 @@ func TestPluralFunc_ko(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ko"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3615,6 +6972,20 @@ func TestPluralFunc_ko(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3623,6 +6994,20 @@ func TestPluralFunc_ko(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -3642,6 +7027,19 @@ func TestPluralFunc_ks(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3649,8 +7047,20 @@ func TestPluralFunc_ks(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3658,6 +7068,7 @@ func TestPluralFunc_ks(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3671,6 +7082,19 @@ func TestPluralFunc_ksb(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3678,8 +7102,20 @@ func TestPluralFunc_ksb(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3687,6 +7123,7 @@ func TestPluralFunc_ksb(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3704,6 +7141,20 @@ func TestPluralFunc_ksh(t *testing.T) {
 		testNamedKey(t, fn, "0.000", `zero`, `fn("0.000", false)`, false)
 		testNamedKey(t, fn, "0.0000", `zero`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3711,8 +7162,20 @@ func TestPluralFunc_ksh(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3720,6 +7183,8 @@ func TestPluralFunc_ksh(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -3733,6 +7198,19 @@ func TestPluralFunc_ku(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3740,8 +7218,20 @@ func TestPluralFunc_ku(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3749,6 +7239,7 @@ func TestPluralFunc_ku(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3820,25 +7311,59 @@ func TestPluralFunc_kw(t *testing.T) {
 		testNamedKey(t, fn, "0.000", `zero`, `fn("0.000", false)`, false)
 		testNamedKey(t, fn, "0.0000", `zero`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1004, `other`, `fn(1004, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
 		testNamedKey(t, fn, "1000.1", `other`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `one`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `one`, `fn(3, true)`, true)
 		testNamedKey(t, fn, 4, `one`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 21, `one`, `fn(21, true)`, true)
+		testNamedKey(t, fn, 22, `one`, `fn(22, true)`, true)
+		testNamedKey(t, fn, 23, `one`, `fn(23, true)`, true)
 		testNamedKey(t, fn, 24, `one`, `fn(24, true)`, true)
 		testNamedKey(t, fn, 41, `one`, `fn(41, true)`, true)
+		testNamedKey(t, fn, 42, `one`, `fn(42, true)`, true)
+		testNamedKey(t, fn, 43, `one`, `fn(43, true)`, true)
 		testNamedKey(t, fn, 44, `one`, `fn(44, true)`, true)
 		testNamedKey(t, fn, 61, `one`, `fn(61, true)`, true)
+		testNamedKey(t, fn, 62, `one`, `fn(62, true)`, true)
+		testNamedKey(t, fn, 63, `one`, `fn(63, true)`, true)
 		testNamedKey(t, fn, 64, `one`, `fn(64, true)`, true)
 		testNamedKey(t, fn, 101, `one`, `fn(101, true)`, true)
 		testNamedKey(t, fn, 1001, `one`, `fn(1001, true)`, true)
@@ -3853,12 +7378,31 @@ func TestPluralFunc_kw(t *testing.T) {
 		testNamedKey(t, fn, 1005, `many`, `fn(1005, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "5.0", `many`, `fn("5.0", true)`, true)
+		testNamedKey(t, fn, "5.5", `other`, `fn("5.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -3872,6 +7416,19 @@ func TestPluralFunc_ky(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3879,8 +7436,20 @@ func TestPluralFunc_ky(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3889,6 +7458,20 @@ func TestPluralFunc_ky(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -3903,6 +7486,20 @@ func TestPluralFunc_lag(t *testing.T) {
 	if nil != fn {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `one`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, 0, `zero`, `fn(0, false)`, false)
 		testNamedKey(t, fn, "0.0", `zero`, `fn("0.0", false)`, false)
@@ -3910,6 +7507,20 @@ func TestPluralFunc_lag(t *testing.T) {
 		testNamedKey(t, fn, "0.000", `zero`, `fn("0.000", false)`, false)
 		testNamedKey(t, fn, "0.0000", `zero`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3917,6 +7528,20 @@ func TestPluralFunc_lag(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
+		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3924,6 +7549,8 @@ func TestPluralFunc_lag(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -3937,6 +7564,19 @@ func TestPluralFunc_lb(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3944,8 +7584,20 @@ func TestPluralFunc_lb(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3953,6 +7605,7 @@ func TestPluralFunc_lb(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3966,6 +7619,19 @@ func TestPluralFunc_lg(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3973,8 +7639,20 @@ func TestPluralFunc_lg(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -3982,6 +7660,7 @@ func TestPluralFunc_lg(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -3989,6 +7668,20 @@ func TestPluralFunc_lkt(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("lkt"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -3996,6 +7689,20 @@ func TestPluralFunc_lkt(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4020,6 +7727,20 @@ func TestPluralFunc_ln(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4027,8 +7748,20 @@ func TestPluralFunc_ln(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4036,6 +7769,7 @@ func TestPluralFunc_ln(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -4043,6 +7777,20 @@ func TestPluralFunc_lo(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("lo"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4050,6 +7798,20 @@ func TestPluralFunc_lo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4060,12 +7822,29 @@ func TestPluralFunc_lo(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -4093,8 +7872,20 @@ func TestPluralFunc_lt(t *testing.T) {
 		testNamedKey(t, fn, "101.0", `one`, `fn("101.0", false)`, false)
 		testNamedKey(t, fn, "1001.0", `one`, `fn("1001.0", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
 		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `few`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `few`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `few`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `few`, `fn(28, false)`, false)
 		testNamedKey(t, fn, 29, `few`, `fn(29, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
@@ -4110,14 +7901,35 @@ func TestPluralFunc_lt(t *testing.T) {
 		testNamedKey(t, fn, "102.0", `few`, `fn("102.0", false)`, false)
 		testNamedKey(t, fn, "1002.0", `few`, `fn("1002.0", false)`, false)
 		testNamedKey(t, fn, "0.1", `many`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `many`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `many`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `many`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `many`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `many`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `many`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `many`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `many`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `many`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `many`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `many`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `many`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `many`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `many`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `many`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.1", `many`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.1", `many`, `fn("100.1", false)`, false)
 		testNamedKey(t, fn, "1000.1", `many`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
 		testNamedKey(t, fn, 30, `other`, `fn(30, false)`, false)
 		testNamedKey(t, fn, 40, `other`, `fn(40, false)`, false)
@@ -4142,6 +7954,20 @@ func TestPluralFunc_lt(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4178,6 +8004,15 @@ func TestPluralFunc_lv(t *testing.T) {
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 0, `zero`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 10, `zero`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `zero`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `zero`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `zero`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `zero`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `zero`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `zero`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `zero`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `zero`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `zero`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 20, `zero`, `fn(20, false)`, false)
 		testNamedKey(t, fn, 30, `zero`, `fn(30, false)`, false)
 		testNamedKey(t, fn, 40, `zero`, `fn(40, false)`, false)
@@ -4202,19 +8037,57 @@ func TestPluralFunc_lv(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `zero`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `zero`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
 		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `other`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `other`, `fn(28, false)`, false)
 		testNamedKey(t, fn, 29, `other`, `fn(29, false)`, false)
 		testNamedKey(t, fn, 102, `other`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `other`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
 		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "10.2", `other`, `fn("10.2", false)`, false)
 		testNamedKey(t, fn, "100.2", `other`, `fn("100.2", false)`, false)
 		testNamedKey(t, fn, "1000.2", `other`, `fn("1000.2", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4234,6 +8107,19 @@ func TestPluralFunc_mas(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4241,8 +8127,20 @@ func TestPluralFunc_mas(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4250,6 +8148,7 @@ func TestPluralFunc_mas(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4267,6 +8166,20 @@ func TestPluralFunc_mg(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4274,8 +8187,20 @@ func TestPluralFunc_mg(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4283,6 +8208,7 @@ func TestPluralFunc_mg(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -4296,6 +8222,19 @@ func TestPluralFunc_mgo(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4303,8 +8242,20 @@ func TestPluralFunc_mgo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4312,6 +8263,7 @@ func TestPluralFunc_mgo(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4341,6 +8293,19 @@ func TestPluralFunc_mk(t *testing.T) {
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4349,8 +8314,19 @@ func TestPluralFunc_mk(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4398,14 +8374,33 @@ func TestPluralFunc_mk(t *testing.T) {
 		testNamedKey(t, fn, 1007, `many`, `fn(1007, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "7.0", `many`, `fn("7.0", true)`, true)
+		testNamedKey(t, fn, "7.5", `other`, `fn("7.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -4419,6 +8414,19 @@ func TestPluralFunc_ml(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4426,8 +8434,20 @@ func TestPluralFunc_ml(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4436,6 +8456,20 @@ func TestPluralFunc_ml(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4455,6 +8489,19 @@ func TestPluralFunc_mn(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4462,8 +8509,20 @@ func TestPluralFunc_mn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4472,6 +8531,20 @@ func TestPluralFunc_mn(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4487,10 +8560,37 @@ func TestPluralFunc_roMD(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `few`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `few`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `few`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `few`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `few`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `few`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `few`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.0", `few`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `few`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `few`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `few`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `few`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `few`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `few`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `few`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `few`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `few`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `few`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `few`, `fn("100.0", false)`, false)
@@ -4499,6 +8599,20 @@ func TestPluralFunc_roMD(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `few`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `few`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `other`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `other`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `other`, `fn(28, false)`, false)
+		testNamedKey(t, fn, 29, `other`, `fn(29, false)`, false)
+		testNamedKey(t, fn, 30, `other`, `fn(30, false)`, false)
+		testNamedKey(t, fn, 31, `other`, `fn(31, false)`, false)
+		testNamedKey(t, fn, 32, `other`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `other`, `fn(33, false)`, false)
+		testNamedKey(t, fn, 34, `other`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 35, `other`, `fn(35, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4508,12 +8622,29 @@ func TestPluralFunc_roMD(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -4527,6 +8658,19 @@ func TestPluralFunc_mr(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4534,8 +8678,20 @@ func TestPluralFunc_mr(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4549,12 +8705,33 @@ func TestPluralFunc_mr(t *testing.T) {
 		testNamedKey(t, fn, 4, `few`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -4562,6 +8739,20 @@ func TestPluralFunc_ms(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ms"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4569,6 +8760,20 @@ func TestPluralFunc_ms(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4579,12 +8784,29 @@ func TestPluralFunc_ms(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -4598,8 +8820,19 @@ func TestPluralFunc_mt(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `few`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
+		testNamedKey(t, fn, 103, `few`, `fn(103, false)`, false)
+		testNamedKey(t, fn, 104, `few`, `fn(104, false)`, false)
+		testNamedKey(t, fn, 105, `few`, `fn(105, false)`, false)
+		testNamedKey(t, fn, 106, `few`, `fn(106, false)`, false)
 		testNamedKey(t, fn, 107, `few`, `fn(107, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.0", `few`, `fn("0.0", false)`, false)
@@ -4614,8 +8847,20 @@ func TestPluralFunc_mt(t *testing.T) {
 		testNamedKey(t, fn, "102.0", `few`, `fn("102.0", false)`, false)
 		testNamedKey(t, fn, "1002.0", `few`, `fn("1002.0", false)`, false)
 		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 111, `many`, `fn(111, false)`, false)
+		testNamedKey(t, fn, 112, `many`, `fn(112, false)`, false)
+		testNamedKey(t, fn, 113, `many`, `fn(113, false)`, false)
+		testNamedKey(t, fn, 114, `many`, `fn(114, false)`, false)
+		testNamedKey(t, fn, 115, `many`, `fn(115, false)`, false)
+		testNamedKey(t, fn, 116, `many`, `fn(116, false)`, false)
 		testNamedKey(t, fn, 117, `many`, `fn(117, false)`, false)
 		testNamedKey(t, fn, 1011, `many`, `fn(1011, false)`, false)
 		testNamedKey(t, fn, "11.0", `many`, `fn("11.0", false)`, false)
@@ -4629,6 +8874,20 @@ func TestPluralFunc_mt(t *testing.T) {
 		testNamedKey(t, fn, "111.0", `many`, `fn("111.0", false)`, false)
 		testNamedKey(t, fn, "1011.0", `many`, `fn("1011.0", false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `other`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `other`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `other`, `fn(28, false)`, false)
+		testNamedKey(t, fn, 29, `other`, `fn(29, false)`, false)
+		testNamedKey(t, fn, 30, `other`, `fn(30, false)`, false)
+		testNamedKey(t, fn, 31, `other`, `fn(31, false)`, false)
+		testNamedKey(t, fn, 32, `other`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `other`, `fn(33, false)`, false)
+		testNamedKey(t, fn, 34, `other`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 35, `other`, `fn(35, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4636,8 +8895,20 @@ func TestPluralFunc_mt(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4645,6 +8916,9 @@ func TestPluralFunc_mt(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
 	}
 }
 
@@ -4652,6 +8926,20 @@ func TestPluralFunc_my(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("my"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4659,6 +8947,20 @@ func TestPluralFunc_my(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4667,6 +8969,20 @@ func TestPluralFunc_my(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4686,6 +9002,19 @@ func TestPluralFunc_nah(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4693,8 +9022,20 @@ func TestPluralFunc_nah(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4702,6 +9043,7 @@ func TestPluralFunc_nah(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4720,6 +9062,19 @@ func TestPluralFunc_naq(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4727,8 +9082,20 @@ func TestPluralFunc_naq(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4736,6 +9103,8 @@ func TestPluralFunc_naq(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -4749,6 +9118,19 @@ func TestPluralFunc_nb(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4756,8 +9138,20 @@ func TestPluralFunc_nb(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4766,6 +9160,20 @@ func TestPluralFunc_nb(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4785,6 +9193,19 @@ func TestPluralFunc_nd(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4792,8 +9213,20 @@ func TestPluralFunc_nd(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4801,6 +9234,7 @@ func TestPluralFunc_nd(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4814,6 +9248,19 @@ func TestPluralFunc_ne(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4821,8 +9268,20 @@ func TestPluralFunc_ne(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4831,15 +9290,34 @@ func TestPluralFunc_ne(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `one`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `one`, `fn(3, true)`, true)
 		testNamedKey(t, fn, 4, `one`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
 		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -4849,6 +9327,19 @@ func TestPluralFunc_nl(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4856,6 +9347,20 @@ func TestPluralFunc_nl(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4864,6 +9369,20 @@ func TestPluralFunc_nl(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -4883,6 +9402,19 @@ func TestPluralFunc_nn(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4890,8 +9422,20 @@ func TestPluralFunc_nn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4899,6 +9443,7 @@ func TestPluralFunc_nn(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4912,6 +9457,19 @@ func TestPluralFunc_nnh(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4919,8 +9477,20 @@ func TestPluralFunc_nnh(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4928,6 +9498,7 @@ func TestPluralFunc_nnh(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4941,6 +9512,19 @@ func TestPluralFunc_no(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4948,8 +9532,20 @@ func TestPluralFunc_no(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4957,6 +9553,7 @@ func TestPluralFunc_no(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -4964,6 +9561,20 @@ func TestPluralFunc_nqo(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("nqo"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4971,6 +9582,20 @@ func TestPluralFunc_nqo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -4991,6 +9616,19 @@ func TestPluralFunc_nr(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -4998,8 +9636,20 @@ func TestPluralFunc_nr(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5007,6 +9657,7 @@ func TestPluralFunc_nr(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5024,6 +9675,20 @@ func TestPluralFunc_nso(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5031,8 +9696,20 @@ func TestPluralFunc_nso(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5040,6 +9717,7 @@ func TestPluralFunc_nso(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -5053,6 +9731,19 @@ func TestPluralFunc_ny(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5060,8 +9751,20 @@ func TestPluralFunc_ny(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5069,6 +9772,7 @@ func TestPluralFunc_ny(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5082,6 +9786,19 @@ func TestPluralFunc_nyn(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5089,8 +9806,20 @@ func TestPluralFunc_nyn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5098,6 +9827,7 @@ func TestPluralFunc_nyn(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5111,6 +9841,19 @@ func TestPluralFunc_om(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5118,8 +9861,20 @@ func TestPluralFunc_om(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5127,6 +9882,7 @@ func TestPluralFunc_om(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5140,6 +9896,19 @@ func TestPluralFunc_or(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5147,8 +9916,20 @@ func TestPluralFunc_or(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5159,6 +9940,7 @@ func TestPluralFunc_or(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 5, `one`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `one`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `one`, `fn(8, true)`, true)
 		testNamedKey(t, fn, 9, `one`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 2, `two`, `fn(2, true)`, true)
 		testNamedKey(t, fn, 3, `two`, `fn(3, true)`, true)
@@ -5166,12 +9948,35 @@ func TestPluralFunc_or(t *testing.T) {
 		testNamedKey(t, fn, 6, `many`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
+		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
+		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
+		testNamedKey(t, fn, 19, `other`, `fn(19, true)`, true)
+		testNamedKey(t, fn, 20, `other`, `fn(20, true)`, true)
+		testNamedKey(t, fn, 21, `other`, `fn(21, true)`, true)
+		testNamedKey(t, fn, 22, `other`, `fn(22, true)`, true)
+		testNamedKey(t, fn, 23, `other`, `fn(23, true)`, true)
 		testNamedKey(t, fn, 24, `other`, `fn(24, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "2.0", `two`, `fn("2.0", true)`, true)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `few`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "6.0", `many`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -5185,6 +9990,19 @@ func TestPluralFunc_os(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5192,8 +10010,20 @@ func TestPluralFunc_os(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5201,6 +10031,7 @@ func TestPluralFunc_os(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5208,6 +10039,20 @@ func TestPluralFunc_osa(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("osa"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5215,6 +10060,20 @@ func TestPluralFunc_osa(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5239,6 +10098,20 @@ func TestPluralFunc_pa(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5246,8 +10119,20 @@ func TestPluralFunc_pa(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5256,6 +10141,20 @@ func TestPluralFunc_pa(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5275,6 +10174,19 @@ func TestPluralFunc_pap(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5282,8 +10194,20 @@ func TestPluralFunc_pap(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5291,6 +10215,7 @@ func TestPluralFunc_pap(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5299,20 +10224,38 @@ func TestPluralFunc_pl(t *testing.T) {
 	if nil != fn {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, 0, `many`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `many`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `many`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `many`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `many`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `many`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `many`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `many`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `many`, `fn(1000, false)`, false)
@@ -5320,6 +10263,20 @@ func TestPluralFunc_pl(t *testing.T) {
 		testNamedKey(t, fn, 100000, `many`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `many`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5328,6 +10285,20 @@ func TestPluralFunc_pl(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5364,6 +10335,15 @@ func TestPluralFunc_prg(t *testing.T) {
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 0, `zero`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 10, `zero`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `zero`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `zero`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `zero`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `zero`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `zero`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `zero`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `zero`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `zero`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `zero`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 20, `zero`, `fn(20, false)`, false)
 		testNamedKey(t, fn, 30, `zero`, `fn(30, false)`, false)
 		testNamedKey(t, fn, 40, `zero`, `fn(40, false)`, false)
@@ -5388,19 +10368,57 @@ func TestPluralFunc_prg(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `zero`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `zero`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
 		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `other`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `other`, `fn(28, false)`, false)
 		testNamedKey(t, fn, 29, `other`, `fn(29, false)`, false)
 		testNamedKey(t, fn, 102, `other`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `other`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
 		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "10.2", `other`, `fn("10.2", false)`, false)
 		testNamedKey(t, fn, "100.2", `other`, `fn("100.2", false)`, false)
 		testNamedKey(t, fn, "1000.2", `other`, `fn("1000.2", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5420,6 +10438,19 @@ func TestPluralFunc_ps(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5427,8 +10458,20 @@ func TestPluralFunc_ps(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5437,6 +10480,20 @@ func TestPluralFunc_ps(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5452,8 +10509,36 @@ func TestPluralFunc_pt(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `one`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `one`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `one`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `one`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `one`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5461,6 +10546,20 @@ func TestPluralFunc_pt(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
+		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
+		testNamedKey(t, fn, "2.8", `other`, `fn("2.8", false)`, false)
+		testNamedKey(t, fn, "2.9", `other`, `fn("2.9", false)`, false)
+		testNamedKey(t, fn, "3.0", `other`, `fn("3.0", false)`, false)
+		testNamedKey(t, fn, "3.1", `other`, `fn("3.1", false)`, false)
+		testNamedKey(t, fn, "3.2", `other`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `other`, `fn("3.3", false)`, false)
+		testNamedKey(t, fn, "3.4", `other`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5469,6 +10568,20 @@ func TestPluralFunc_pt(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5484,6 +10597,19 @@ func TestPluralFunc_ptPT(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5491,6 +10617,20 @@ func TestPluralFunc_ptPT(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5498,6 +10638,7 @@ func TestPluralFunc_ptPT(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5511,6 +10652,19 @@ func TestPluralFunc_rm(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5518,8 +10672,20 @@ func TestPluralFunc_rm(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5527,6 +10693,7 @@ func TestPluralFunc_rm(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5536,10 +10703,37 @@ func TestPluralFunc_ro(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `few`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `few`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `few`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `few`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `few`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `few`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `few`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.0", `few`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `few`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `few`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `few`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `few`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `few`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `few`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `few`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `few`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `few`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `few`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `few`, `fn("100.0", false)`, false)
@@ -5548,6 +10742,20 @@ func TestPluralFunc_ro(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `few`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `few`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `other`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
+		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
+		testNamedKey(t, fn, 27, `other`, `fn(27, false)`, false)
+		testNamedKey(t, fn, 28, `other`, `fn(28, false)`, false)
+		testNamedKey(t, fn, 29, `other`, `fn(29, false)`, false)
+		testNamedKey(t, fn, 30, `other`, `fn(30, false)`, false)
+		testNamedKey(t, fn, 31, `other`, `fn(31, false)`, false)
+		testNamedKey(t, fn, 32, `other`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `other`, `fn(33, false)`, false)
+		testNamedKey(t, fn, 34, `other`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 35, `other`, `fn(35, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5557,12 +10765,29 @@ func TestPluralFunc_ro(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -5576,6 +10801,19 @@ func TestPluralFunc_rof(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5583,8 +10821,20 @@ func TestPluralFunc_rof(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5592,6 +10842,7 @@ func TestPluralFunc_rof(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5599,6 +10850,20 @@ func TestPluralFunc_und(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("und"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5606,6 +10871,20 @@ func TestPluralFunc_und(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5614,6 +10893,20 @@ func TestPluralFunc_und(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5637,20 +10930,38 @@ func TestPluralFunc_ru(t *testing.T) {
 		testNamedKey(t, fn, 101, `one`, `fn(101, false)`, false)
 		testNamedKey(t, fn, 1001, `one`, `fn(1001, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, 0, `many`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `many`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `many`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `many`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `many`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `many`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `many`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `many`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `many`, `fn(1000, false)`, false)
@@ -5658,6 +10969,20 @@ func TestPluralFunc_ru(t *testing.T) {
 		testNamedKey(t, fn, 100000, `many`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `many`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5666,6 +10991,20 @@ func TestPluralFunc_ru(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5685,6 +11024,19 @@ func TestPluralFunc_rwk(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5692,8 +11044,20 @@ func TestPluralFunc_rwk(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5701,6 +11065,7 @@ func TestPluralFunc_rwk(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5708,6 +11073,20 @@ func TestPluralFunc_sah(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("sah"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5715,6 +11094,20 @@ func TestPluralFunc_sah(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5735,6 +11128,19 @@ func TestPluralFunc_saq(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5742,8 +11148,20 @@ func TestPluralFunc_saq(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5751,6 +11169,7 @@ func TestPluralFunc_saq(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5760,6 +11179,19 @@ func TestPluralFunc_sc(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5767,6 +11199,20 @@ func TestPluralFunc_sc(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5779,16 +11225,30 @@ func TestPluralFunc_sc(t *testing.T) {
 		testNamedKey(t, fn, 80, `many`, `fn(80, true)`, true)
 		testNamedKey(t, fn, 800, `many`, `fn(800, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
 		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
 		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "8.0", `many`, `fn("8.0", true)`, true)
+		testNamedKey(t, fn, "8.5", `other`, `fn("8.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -5798,6 +11258,19 @@ func TestPluralFunc_scn(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5805,6 +11278,20 @@ func TestPluralFunc_scn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5817,16 +11304,30 @@ func TestPluralFunc_scn(t *testing.T) {
 		testNamedKey(t, fn, 80, `many`, `fn(80, true)`, true)
 		testNamedKey(t, fn, 800, `many`, `fn(800, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
 		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
 		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
 		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "8.0", `many`, `fn("8.0", true)`, true)
+		testNamedKey(t, fn, "8.5", `other`, `fn("8.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -5840,6 +11341,19 @@ func TestPluralFunc_sd(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5847,8 +11361,20 @@ func TestPluralFunc_sd(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5857,6 +11383,20 @@ func TestPluralFunc_sd(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -5876,6 +11416,19 @@ func TestPluralFunc_sdh(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5883,8 +11436,20 @@ func TestPluralFunc_sdh(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5892,6 +11457,7 @@ func TestPluralFunc_sdh(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5910,6 +11476,19 @@ func TestPluralFunc_se(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5917,8 +11496,20 @@ func TestPluralFunc_se(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5926,6 +11517,8 @@ func TestPluralFunc_se(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -5939,6 +11532,19 @@ func TestPluralFunc_seh(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5946,8 +11552,20 @@ func TestPluralFunc_seh(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5955,6 +11573,7 @@ func TestPluralFunc_seh(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -5962,6 +11581,20 @@ func TestPluralFunc_ses(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("ses"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5969,6 +11602,20 @@ func TestPluralFunc_ses(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -5983,6 +11630,20 @@ func TestPluralFunc_sg(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("sg"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -5990,6 +11651,20 @@ func TestPluralFunc_sg(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6025,27 +11700,37 @@ func TestPluralFunc_srLatn(t *testing.T) {
 		testNamedKey(t, fn, "100.1", `one`, `fn("100.1", false)`, false)
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
 		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
 		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
 		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "2.2", `few`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `few`, `fn("2.3", false)`, false)
 		testNamedKey(t, fn, "2.4", `few`, `fn("2.4", false)`, false)
 		testNamedKey(t, fn, "3.2", `few`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `few`, `fn("3.3", false)`, false)
 		testNamedKey(t, fn, "3.4", `few`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "4.2", `few`, `fn("4.2", false)`, false)
+		testNamedKey(t, fn, "4.3", `few`, `fn("4.3", false)`, false)
 		testNamedKey(t, fn, "4.4", `few`, `fn("4.4", false)`, false)
 		testNamedKey(t, fn, "5.2", `few`, `fn("5.2", false)`, false)
 		testNamedKey(t, fn, "10.2", `few`, `fn("10.2", false)`, false)
@@ -6053,6 +11738,19 @@ func TestPluralFunc_srLatn(t *testing.T) {
 		testNamedKey(t, fn, "1000.2", `few`, `fn("1000.2", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6061,10 +11759,19 @@ func TestPluralFunc_srLatn(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6073,6 +11780,20 @@ func TestPluralFunc_srLatn(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6088,10 +11809,29 @@ func TestPluralFunc_shi(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `few`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `few`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `few`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `few`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `few`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `few`, `fn(10, false)`, false)
 		testNamedKey(t, fn, "2.0", `few`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", false)`, false)
@@ -6110,6 +11850,20 @@ func TestPluralFunc_shi(t *testing.T) {
 		testNamedKey(t, fn, "7.00", `few`, `fn("7.00", false)`, false)
 		testNamedKey(t, fn, "8.00", `few`, `fn("8.00", false)`, false)
 		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
+		testNamedKey(t, fn, 20, `other`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `other`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `other`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `other`, `fn(23, false)`, false)
+		testNamedKey(t, fn, 24, `other`, `fn(24, false)`, false)
+		testNamedKey(t, fn, 25, `other`, `fn(25, false)`, false)
 		testNamedKey(t, fn, 26, `other`, `fn(26, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6117,8 +11871,20 @@ func TestPluralFunc_shi(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
 		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.1", `other`, `fn("10.1", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6126,6 +11892,8 @@ func TestPluralFunc_shi(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -6147,6 +11915,20 @@ func TestPluralFunc_si(t *testing.T) {
 		testNamedKey(t, fn, "0.0001", `one`, `fn("0.0001", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6154,8 +11936,20 @@ func TestPluralFunc_si(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6164,6 +11958,20 @@ func TestPluralFunc_si(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6178,8 +11986,23 @@ func TestPluralFunc_sk(t *testing.T) {
 	if nil != fn {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, "0.0", `many`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `many`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `many`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `many`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `many`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `many`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `many`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `many`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `many`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `many`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `many`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `many`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `many`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `many`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `many`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `many`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `many`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `many`, `fn("100.0", false)`, false)
@@ -6189,6 +12012,19 @@ func TestPluralFunc_sk(t *testing.T) {
 		testNamedKey(t, fn, "1000000.0", `many`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6196,6 +12032,20 @@ func TestPluralFunc_sk(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6244,6 +12094,20 @@ func TestPluralFunc_sl(t *testing.T) {
 		testNamedKey(t, fn, 704, `few`, `fn(704, false)`, false)
 		testNamedKey(t, fn, 1003, `few`, `fn(1003, false)`, false)
 		testNamedKey(t, fn, "0.0", `few`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `few`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `few`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `few`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `few`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `few`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `few`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `few`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `few`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `few`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `few`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `few`, `fn("100.0", false)`, false)
@@ -6253,6 +12117,19 @@ func TestPluralFunc_sl(t *testing.T) {
 		testNamedKey(t, fn, "1000000.0", `few`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6260,6 +12137,20 @@ func TestPluralFunc_sl(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6284,6 +12175,19 @@ func TestPluralFunc_sma(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6291,8 +12195,20 @@ func TestPluralFunc_sma(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6300,6 +12216,8 @@ func TestPluralFunc_sma(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -6318,6 +12236,19 @@ func TestPluralFunc_smi(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6325,8 +12256,20 @@ func TestPluralFunc_smi(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6334,6 +12277,8 @@ func TestPluralFunc_smi(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -6352,6 +12297,19 @@ func TestPluralFunc_smj(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6359,8 +12317,20 @@ func TestPluralFunc_smj(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6368,6 +12338,8 @@ func TestPluralFunc_smj(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -6386,6 +12358,19 @@ func TestPluralFunc_smn(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6393,8 +12378,20 @@ func TestPluralFunc_smn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6402,6 +12399,8 @@ func TestPluralFunc_smn(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -6420,6 +12419,19 @@ func TestPluralFunc_sms(t *testing.T) {
 		testNamedKey(t, fn, "2.0000", `two`, `fn("2.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6427,8 +12439,20 @@ func TestPluralFunc_sms(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6436,6 +12460,8 @@ func TestPluralFunc_sms(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 	}
 }
 
@@ -6449,6 +12475,19 @@ func TestPluralFunc_sn(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6456,8 +12495,20 @@ func TestPluralFunc_sn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6465,6 +12516,7 @@ func TestPluralFunc_sn(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6478,6 +12530,19 @@ func TestPluralFunc_so(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6485,8 +12550,20 @@ func TestPluralFunc_so(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6494,6 +12571,7 @@ func TestPluralFunc_so(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6507,6 +12585,19 @@ func TestPluralFunc_sq(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6514,8 +12605,20 @@ func TestPluralFunc_sq(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6538,12 +12641,29 @@ func TestPluralFunc_sq(t *testing.T) {
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "4.0", `many`, `fn("4.0", true)`, true)
+		testNamedKey(t, fn, "4.5", `other`, `fn("4.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -6572,27 +12692,37 @@ func TestPluralFunc_sr(t *testing.T) {
 		testNamedKey(t, fn, "100.1", `one`, `fn("100.1", false)`, false)
 		testNamedKey(t, fn, "1000.1", `one`, `fn("1000.1", false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, "0.2", `few`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `few`, `fn("0.3", false)`, false)
 		testNamedKey(t, fn, "0.4", `few`, `fn("0.4", false)`, false)
 		testNamedKey(t, fn, "1.2", `few`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `few`, `fn("1.3", false)`, false)
 		testNamedKey(t, fn, "1.4", `few`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "2.2", `few`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `few`, `fn("2.3", false)`, false)
 		testNamedKey(t, fn, "2.4", `few`, `fn("2.4", false)`, false)
 		testNamedKey(t, fn, "3.2", `few`, `fn("3.2", false)`, false)
+		testNamedKey(t, fn, "3.3", `few`, `fn("3.3", false)`, false)
 		testNamedKey(t, fn, "3.4", `few`, `fn("3.4", false)`, false)
 		testNamedKey(t, fn, "4.2", `few`, `fn("4.2", false)`, false)
+		testNamedKey(t, fn, "4.3", `few`, `fn("4.3", false)`, false)
 		testNamedKey(t, fn, "4.4", `few`, `fn("4.4", false)`, false)
 		testNamedKey(t, fn, "5.2", `few`, `fn("5.2", false)`, false)
 		testNamedKey(t, fn, "10.2", `few`, `fn("10.2", false)`, false)
@@ -6600,6 +12730,19 @@ func TestPluralFunc_sr(t *testing.T) {
 		testNamedKey(t, fn, "1000.2", `few`, `fn("1000.2", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `other`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `other`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6608,10 +12751,19 @@ func TestPluralFunc_sr(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
 		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
 		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
+		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "2.7", `other`, `fn("2.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6620,6 +12772,20 @@ func TestPluralFunc_sr(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6639,6 +12805,19 @@ func TestPluralFunc_ss(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6646,8 +12825,20 @@ func TestPluralFunc_ss(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6655,6 +12846,7 @@ func TestPluralFunc_ss(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6668,6 +12860,19 @@ func TestPluralFunc_ssy(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6675,8 +12880,20 @@ func TestPluralFunc_ssy(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6684,6 +12901,7 @@ func TestPluralFunc_ssy(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6697,6 +12915,19 @@ func TestPluralFunc_st(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6704,8 +12935,20 @@ func TestPluralFunc_st(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6713,6 +12956,7 @@ func TestPluralFunc_st(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6720,6 +12964,20 @@ func TestPluralFunc_su(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("su"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6727,6 +12985,20 @@ func TestPluralFunc_su(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6743,6 +13015,19 @@ func TestPluralFunc_sv(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6750,6 +13035,20 @@ func TestPluralFunc_sv(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6777,12 +13076,29 @@ func TestPluralFunc_sv(t *testing.T) {
 		testNamedKey(t, fn, 1001, `one`, `fn(1001, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
+		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -6792,6 +13108,19 @@ func TestPluralFunc_sw(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6799,6 +13128,20 @@ func TestPluralFunc_sw(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6807,6 +13150,20 @@ func TestPluralFunc_sw(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6826,6 +13183,19 @@ func TestPluralFunc_syr(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6833,8 +13203,20 @@ func TestPluralFunc_syr(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6842,6 +13224,7 @@ func TestPluralFunc_syr(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6855,6 +13238,19 @@ func TestPluralFunc_ta(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6862,8 +13258,20 @@ func TestPluralFunc_ta(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6872,6 +13280,20 @@ func TestPluralFunc_ta(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6891,6 +13313,19 @@ func TestPluralFunc_te(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6898,8 +13333,20 @@ func TestPluralFunc_te(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6908,6 +13355,20 @@ func TestPluralFunc_te(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6927,6 +13388,19 @@ func TestPluralFunc_teo(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6934,8 +13408,20 @@ func TestPluralFunc_teo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6943,6 +13429,7 @@ func TestPluralFunc_teo(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -6950,6 +13437,20 @@ func TestPluralFunc_th(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("th"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6957,6 +13458,20 @@ func TestPluralFunc_th(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -6965,6 +13480,20 @@ func TestPluralFunc_th(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -6988,6 +13517,20 @@ func TestPluralFunc_ti(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -6995,8 +13538,20 @@ func TestPluralFunc_ti(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7004,6 +13559,7 @@ func TestPluralFunc_ti(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -7017,6 +13573,19 @@ func TestPluralFunc_tig(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7024,8 +13593,20 @@ func TestPluralFunc_tig(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7033,6 +13614,7 @@ func TestPluralFunc_tig(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7046,6 +13628,19 @@ func TestPluralFunc_tk(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7053,8 +13648,20 @@ func TestPluralFunc_tk(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7074,10 +13681,17 @@ func TestPluralFunc_tk(t *testing.T) {
 		testNamedKey(t, fn, 106, `few`, `fn(106, true)`, true)
 		testNamedKey(t, fn, 1006, `few`, `fn(1006, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
 		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
 		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
 		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
 		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 17, `other`, `fn(17, true)`, true)
 		testNamedKey(t, fn, 18, `other`, `fn(18, true)`, true)
@@ -7087,6 +13701,10 @@ func TestPluralFunc_tk(t *testing.T) {
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "6.0", `few`, `fn("6.0", true)`, true)
+		testNamedKey(t, fn, "6.5", `other`, `fn("6.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -7100,6 +13718,19 @@ func TestPluralFunc_tn(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7107,8 +13738,20 @@ func TestPluralFunc_tn(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7116,6 +13759,7 @@ func TestPluralFunc_tn(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7123,6 +13767,20 @@ func TestPluralFunc_to(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("to"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7130,6 +13788,20 @@ func TestPluralFunc_to(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7150,6 +13822,19 @@ func TestPluralFunc_tr(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7157,8 +13842,20 @@ func TestPluralFunc_tr(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7167,6 +13864,20 @@ func TestPluralFunc_tr(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -7186,6 +13897,19 @@ func TestPluralFunc_ts(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7193,8 +13917,20 @@ func TestPluralFunc_ts(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7202,6 +13938,7 @@ func TestPluralFunc_ts(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7211,6 +13948,18 @@ func TestPluralFunc_tzm(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 11, `one`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `one`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `one`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `one`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `one`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `one`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `one`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `one`, `fn(18, false)`, false)
+		testNamedKey(t, fn, 19, `one`, `fn(19, false)`, false)
+		testNamedKey(t, fn, 20, `one`, `fn(20, false)`, false)
+		testNamedKey(t, fn, 21, `one`, `fn(21, false)`, false)
+		testNamedKey(t, fn, 22, `one`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `one`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `one`, `fn(24, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
@@ -7229,16 +13978,40 @@ func TestPluralFunc_tzm(t *testing.T) {
 		testNamedKey(t, fn, "23.0", `one`, `fn("23.0", false)`, false)
 		testNamedKey(t, fn, "24.0", `one`, `fn("24.0", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
 		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
+		testNamedKey(t, fn, 101, `other`, `fn(101, false)`, false)
+		testNamedKey(t, fn, 102, `other`, `fn(102, false)`, false)
+		testNamedKey(t, fn, 103, `other`, `fn(103, false)`, false)
+		testNamedKey(t, fn, 104, `other`, `fn(104, false)`, false)
+		testNamedKey(t, fn, 105, `other`, `fn(105, false)`, false)
 		testNamedKey(t, fn, 106, `other`, `fn(106, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, false)`, false)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7246,6 +14019,7 @@ func TestPluralFunc_tzm(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -7259,6 +14033,19 @@ func TestPluralFunc_ug(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7266,8 +14053,20 @@ func TestPluralFunc_ug(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7275,6 +14074,7 @@ func TestPluralFunc_ug(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7292,20 +14092,38 @@ func TestPluralFunc_uk(t *testing.T) {
 		testNamedKey(t, fn, 101, `one`, `fn(101, false)`, false)
 		testNamedKey(t, fn, 1001, `one`, `fn(1001, false)`, false)
 		testNamedKey(t, fn, 2, `few`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `few`, `fn(3, false)`, false)
 		testNamedKey(t, fn, 4, `few`, `fn(4, false)`, false)
 		testNamedKey(t, fn, 22, `few`, `fn(22, false)`, false)
+		testNamedKey(t, fn, 23, `few`, `fn(23, false)`, false)
 		testNamedKey(t, fn, 24, `few`, `fn(24, false)`, false)
 		testNamedKey(t, fn, 32, `few`, `fn(32, false)`, false)
+		testNamedKey(t, fn, 33, `few`, `fn(33, false)`, false)
 		testNamedKey(t, fn, 34, `few`, `fn(34, false)`, false)
 		testNamedKey(t, fn, 42, `few`, `fn(42, false)`, false)
+		testNamedKey(t, fn, 43, `few`, `fn(43, false)`, false)
 		testNamedKey(t, fn, 44, `few`, `fn(44, false)`, false)
 		testNamedKey(t, fn, 52, `few`, `fn(52, false)`, false)
+		testNamedKey(t, fn, 53, `few`, `fn(53, false)`, false)
 		testNamedKey(t, fn, 54, `few`, `fn(54, false)`, false)
 		testNamedKey(t, fn, 62, `few`, `fn(62, false)`, false)
 		testNamedKey(t, fn, 102, `few`, `fn(102, false)`, false)
 		testNamedKey(t, fn, 1002, `few`, `fn(1002, false)`, false)
 		testNamedKey(t, fn, 0, `many`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 5, `many`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `many`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `many`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `many`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `many`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `many`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `many`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `many`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `many`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `many`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `many`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `many`, `fn(16, false)`, false)
+		testNamedKey(t, fn, 17, `many`, `fn(17, false)`, false)
+		testNamedKey(t, fn, 18, `many`, `fn(18, false)`, false)
 		testNamedKey(t, fn, 19, `many`, `fn(19, false)`, false)
 		testNamedKey(t, fn, 100, `many`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `many`, `fn(1000, false)`, false)
@@ -7313,6 +14131,20 @@ func TestPluralFunc_uk(t *testing.T) {
 		testNamedKey(t, fn, 100000, `many`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `many`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7331,14 +14163,30 @@ func TestPluralFunc_uk(t *testing.T) {
 		testNamedKey(t, fn, 103, `few`, `fn(103, true)`, true)
 		testNamedKey(t, fn, 1003, `few`, `fn(1003, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
 		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "3.0", `few`, `fn("3.0", true)`, true)
+		testNamedKey(t, fn, "3.5", `other`, `fn("3.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -7348,6 +14196,19 @@ func TestPluralFunc_ur(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7355,6 +14216,20 @@ func TestPluralFunc_ur(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7363,6 +14238,20 @@ func TestPluralFunc_ur(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -7382,6 +14271,19 @@ func TestPluralFunc_uz(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7389,8 +14291,20 @@ func TestPluralFunc_uz(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7399,6 +14313,20 @@ func TestPluralFunc_uz(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -7418,6 +14346,19 @@ func TestPluralFunc_ve(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7425,8 +14366,20 @@ func TestPluralFunc_ve(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7434,6 +14387,7 @@ func TestPluralFunc_ve(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7441,6 +14395,20 @@ func TestPluralFunc_vi(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("vi"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7448,6 +14416,20 @@ func TestPluralFunc_vi(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7458,12 +14440,29 @@ func TestPluralFunc_vi(t *testing.T) {
 		testNamedKey(t, fn, 1, `one`, `fn(1, true)`, true)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
+		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 16, `other`, `fn(16, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
 		testNamedKey(t, fn, 10000, `other`, `fn(10000, true)`, true)
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, true)`, true)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
+		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", true)`, true)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", true)`, true)
+		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", true)`, true)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", true)`, true)
 	}
 }
 
@@ -7477,6 +14476,19 @@ func TestPluralFunc_vo(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7484,8 +14496,20 @@ func TestPluralFunc_vo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7493,6 +14517,7 @@ func TestPluralFunc_vo(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7506,6 +14531,19 @@ func TestPluralFunc_vun(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7513,8 +14551,20 @@ func TestPluralFunc_vun(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7522,6 +14572,7 @@ func TestPluralFunc_vun(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7539,6 +14590,20 @@ func TestPluralFunc_wa(t *testing.T) {
 		testNamedKey(t, fn, "0.0000", `one`, `fn("0.0000", false)`, false)
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7546,8 +14611,20 @@ func TestPluralFunc_wa(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7555,6 +14632,7 @@ func TestPluralFunc_wa(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
 	}
 }
 
@@ -7568,6 +14646,19 @@ func TestPluralFunc_wae(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7575,8 +14666,20 @@ func TestPluralFunc_wae(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7584,6 +14687,7 @@ func TestPluralFunc_wae(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7591,6 +14695,20 @@ func TestPluralFunc_wo(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("wo"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7598,6 +14716,20 @@ func TestPluralFunc_wo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7618,6 +14750,19 @@ func TestPluralFunc_xh(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7625,8 +14770,20 @@ func TestPluralFunc_xh(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7634,6 +14791,7 @@ func TestPluralFunc_xh(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7647,6 +14805,19 @@ func TestPluralFunc_xog(t *testing.T) {
 		testNamedKey(t, fn, "1.0000", `one`, `fn("1.0000", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7654,8 +14825,20 @@ func TestPluralFunc_xog(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
 		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7663,6 +14846,7 @@ func TestPluralFunc_xog(t *testing.T) {
 		testNamedKey(t, fn, "10000.0", `other`, `fn("10000.0", false)`, false)
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
 	}
 }
 
@@ -7670,6 +14854,20 @@ func TestPluralFunc_yo(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("yo"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7677,6 +14875,20 @@ func TestPluralFunc_yo(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7691,6 +14903,20 @@ func TestPluralFunc_yue(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("yue"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7698,6 +14924,20 @@ func TestPluralFunc_yue(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7706,6 +14946,20 @@ func TestPluralFunc_yue(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -7719,6 +14973,20 @@ func TestPluralFunc_zh(t *testing.T) {
 	fn := getPluralFunc(t, language.MustParse("zh"))
 	if nil != fn {
 		testNamedKey(t, fn, 0, `other`, `fn(0, false)`, false)
+		testNamedKey(t, fn, 1, `other`, `fn(1, false)`, false)
+		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
 		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7726,6 +14994,20 @@ func TestPluralFunc_zh(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "0.0", `other`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `other`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `other`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `other`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `other`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `other`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `other`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `other`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `other`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `other`, `fn("0.9", false)`, false)
+		testNamedKey(t, fn, "1.0", `other`, `fn("1.0", false)`, false)
+		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
 		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7734,6 +15016,20 @@ func TestPluralFunc_zh(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -7749,10 +15045,36 @@ func TestPluralFunc_zu(t *testing.T) {
 		testNamedKey(t, fn, 0, `one`, `fn(0, false)`, false)
 		testNamedKey(t, fn, 1, `one`, `fn(1, false)`, false)
 		testNamedKey(t, fn, "0.0", `one`, `fn("0.0", false)`, false)
+		testNamedKey(t, fn, "0.1", `one`, `fn("0.1", false)`, false)
+		testNamedKey(t, fn, "0.2", `one`, `fn("0.2", false)`, false)
+		testNamedKey(t, fn, "0.3", `one`, `fn("0.3", false)`, false)
+		testNamedKey(t, fn, "0.4", `one`, `fn("0.4", false)`, false)
+		testNamedKey(t, fn, "0.5", `one`, `fn("0.5", false)`, false)
+		testNamedKey(t, fn, "0.6", `one`, `fn("0.6", false)`, false)
+		testNamedKey(t, fn, "0.7", `one`, `fn("0.7", false)`, false)
+		testNamedKey(t, fn, "0.8", `one`, `fn("0.8", false)`, false)
+		testNamedKey(t, fn, "0.9", `one`, `fn("0.9", false)`, false)
 		testNamedKey(t, fn, "1.0", `one`, `fn("1.0", false)`, false)
 		testNamedKey(t, fn, "0.00", `one`, `fn("0.00", false)`, false)
+		testNamedKey(t, fn, "0.01", `one`, `fn("0.01", false)`, false)
+		testNamedKey(t, fn, "0.02", `one`, `fn("0.02", false)`, false)
+		testNamedKey(t, fn, "0.03", `one`, `fn("0.03", false)`, false)
 		testNamedKey(t, fn, "0.04", `one`, `fn("0.04", false)`, false)
 		testNamedKey(t, fn, 2, `other`, `fn(2, false)`, false)
+		testNamedKey(t, fn, 3, `other`, `fn(3, false)`, false)
+		testNamedKey(t, fn, 4, `other`, `fn(4, false)`, false)
+		testNamedKey(t, fn, 5, `other`, `fn(5, false)`, false)
+		testNamedKey(t, fn, 6, `other`, `fn(6, false)`, false)
+		testNamedKey(t, fn, 7, `other`, `fn(7, false)`, false)
+		testNamedKey(t, fn, 8, `other`, `fn(8, false)`, false)
+		testNamedKey(t, fn, 9, `other`, `fn(9, false)`, false)
+		testNamedKey(t, fn, 10, `other`, `fn(10, false)`, false)
+		testNamedKey(t, fn, 11, `other`, `fn(11, false)`, false)
+		testNamedKey(t, fn, 12, `other`, `fn(12, false)`, false)
+		testNamedKey(t, fn, 13, `other`, `fn(13, false)`, false)
+		testNamedKey(t, fn, 14, `other`, `fn(14, false)`, false)
+		testNamedKey(t, fn, 15, `other`, `fn(15, false)`, false)
+		testNamedKey(t, fn, 16, `other`, `fn(16, false)`, false)
 		testNamedKey(t, fn, 17, `other`, `fn(17, false)`, false)
 		testNamedKey(t, fn, 100, `other`, `fn(100, false)`, false)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, false)`, false)
@@ -7760,6 +15082,20 @@ func TestPluralFunc_zu(t *testing.T) {
 		testNamedKey(t, fn, 100000, `other`, `fn(100000, false)`, false)
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, false)`, false)
 		testNamedKey(t, fn, "1.1", `other`, `fn("1.1", false)`, false)
+		testNamedKey(t, fn, "1.2", `other`, `fn("1.2", false)`, false)
+		testNamedKey(t, fn, "1.3", `other`, `fn("1.3", false)`, false)
+		testNamedKey(t, fn, "1.4", `other`, `fn("1.4", false)`, false)
+		testNamedKey(t, fn, "1.5", `other`, `fn("1.5", false)`, false)
+		testNamedKey(t, fn, "1.6", `other`, `fn("1.6", false)`, false)
+		testNamedKey(t, fn, "1.7", `other`, `fn("1.7", false)`, false)
+		testNamedKey(t, fn, "1.8", `other`, `fn("1.8", false)`, false)
+		testNamedKey(t, fn, "1.9", `other`, `fn("1.9", false)`, false)
+		testNamedKey(t, fn, "2.0", `other`, `fn("2.0", false)`, false)
+		testNamedKey(t, fn, "2.1", `other`, `fn("2.1", false)`, false)
+		testNamedKey(t, fn, "2.2", `other`, `fn("2.2", false)`, false)
+		testNamedKey(t, fn, "2.3", `other`, `fn("2.3", false)`, false)
+		testNamedKey(t, fn, "2.4", `other`, `fn("2.4", false)`, false)
+		testNamedKey(t, fn, "2.5", `other`, `fn("2.5", false)`, false)
 		testNamedKey(t, fn, "2.6", `other`, `fn("2.6", false)`, false)
 		testNamedKey(t, fn, "10.0", `other`, `fn("10.0", false)`, false)
 		testNamedKey(t, fn, "100.0", `other`, `fn("100.0", false)`, false)
@@ -7768,6 +15104,20 @@ func TestPluralFunc_zu(t *testing.T) {
 		testNamedKey(t, fn, "100000.0", `other`, `fn("100000.0", false)`, false)
 		testNamedKey(t, fn, "1000000.0", `other`, `fn("1000000.0", false)`, false)
 		testNamedKey(t, fn, 0, `other`, `fn(0, true)`, true)
+		testNamedKey(t, fn, 1, `other`, `fn(1, true)`, true)
+		testNamedKey(t, fn, 2, `other`, `fn(2, true)`, true)
+		testNamedKey(t, fn, 3, `other`, `fn(3, true)`, true)
+		testNamedKey(t, fn, 4, `other`, `fn(4, true)`, true)
+		testNamedKey(t, fn, 5, `other`, `fn(5, true)`, true)
+		testNamedKey(t, fn, 6, `other`, `fn(6, true)`, true)
+		testNamedKey(t, fn, 7, `other`, `fn(7, true)`, true)
+		testNamedKey(t, fn, 8, `other`, `fn(8, true)`, true)
+		testNamedKey(t, fn, 9, `other`, `fn(9, true)`, true)
+		testNamedKey(t, fn, 10, `other`, `fn(10, true)`, true)
+		testNamedKey(t, fn, 11, `other`, `fn(11, true)`, true)
+		testNamedKey(t, fn, 12, `other`, `fn(12, true)`, true)
+		testNamedKey(t, fn, 13, `other`, `fn(13, true)`, true)
+		testNamedKey(t, fn, 14, `other`, `fn(14, true)`, true)
 		testNamedKey(t, fn, 15, `other`, `fn(15, true)`, true)
 		testNamedKey(t, fn, 100, `other`, `fn(100, true)`, true)
 		testNamedKey(t, fn, 1000, `other`, `fn(1000, true)`, true)
@@ -7776,3 +15126,63 @@ func TestPluralFunc_zu(t *testing.T) {
 		testNamedKey(t, fn, 1000000, `other`, `fn(1000000, true)`, true)
 	}
 }
+
+// pluralBenchmarks are a few locales of every class of rules, named after
+// their cardinal and ordinal categories, with their samples.
+var pluralBenchmarks = []struct {
+	class, culture      string
+	cardinals, ordinals []string
+}{
+	{"one,other;other", "af", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;other", "ak", []string{"0", "1", "0.0", "1.0", "0.00", "1.00", "0.000", "1.000", "0.0000", "1.0000", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"one,other;other", "am", []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"zero,one,two,few,many,other;other", "ar", []string{"1", "1.0", "1.00", "1.000", "1.0000", "2", "2.0", "2.00", "2.000", "2.0000", "3", "4", "5", "6", "7", "8", "9", "10", "103", "104", "105", "106", "107", "108", "109", "110", "1003", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "103.0", "1003.0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "111", "1011", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0", "0", "0.0", "0.00", "0.000", "0.0000", "100", "101", "102", "200", "201", "202", "300", "301", "302", "400", "401", "402", "500", "501", "502", "600", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"zero,one,two,few,many,other;other", "ars", []string{"1", "1.0", "1.00", "1.000", "1.0000", "2", "2.0", "2.00", "2.000", "2.0000", "3", "4", "5", "6", "7", "8", "9", "10", "103", "104", "105", "106", "107", "108", "109", "110", "1003", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "103.0", "1003.0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "111", "1011", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0", "0", "0.0", "0.00", "0.000", "0.0000", "100", "101", "102", "200", "201", "202", "300", "301", "302", "400", "401", "402", "500", "501", "502", "600", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"one,other;one,two,few,many,other", "as", []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "5", "7", "8", "9", "10", "2", "3", "4", "6", "0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,few,many,other", "az", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "2", "5", "7", "8", "11", "12", "15", "17", "18", "20", "21", "22", "25", "101", "1001", "3", "4", "13", "14", "23", "24", "33", "34", "43", "44", "53", "54", "63", "64", "73", "74", "100", "1003", "0", "6", "16", "26", "36", "40", "46", "56", "106", "1006", "9", "10", "19", "29", "30", "39", "49", "59", "69", "79", "109", "1000", "10000", "100000", "1000000"}},
+	{"one,few,many,other;few,other", "be", []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "1.0", "21.0", "31.0", "41.0", "51.0", "61.0", "71.0", "81.0", "101.0", "1001.0", "2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002", "2.0", "3.0", "4.0", "22.0", "23.0", "24.0", "32.0", "33.0", "102.0", "1002.0", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "11.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.1", "1000.1"}, []string{"2", "3", "22", "23", "32", "33", "42", "43", "52", "53", "62", "63", "72", "73", "82", "83", "102", "1002", "0", "1", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,two,few,many,other;other", "br", []string{"1", "21", "31", "41", "51", "61", "81", "101", "1001", "1.0", "21.0", "31.0", "41.0", "51.0", "61.0", "81.0", "101.0", "1001.0", "2", "22", "32", "42", "52", "62", "82", "102", "1002", "2.0", "22.0", "32.0", "42.0", "52.0", "62.0", "82.0", "102.0", "1002.0", "3", "4", "9", "23", "24", "29", "33", "34", "39", "43", "44", "49", "103", "1003", "3.0", "4.0", "9.0", "23.0", "24.0", "29.0", "33.0", "34.0", "103.0", "1003.0", "1000000", "1000000.0", "1000000.00", "1000000.000", "0", "5", "6", "7", "8", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0"}, nil},
+	{"one,few,other;other", "bs", []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1", "2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002", "0.2", "0.3", "0.4", "1.2", "1.3", "1.4", "2.2", "2.3", "2.4", "3.2", "3.3", "3.4", "4.2", "4.3", "4.4", "5.2", "10.2", "100.2", "1000.2", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.5", "2.6", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,two,few,other", "ca", []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "3", "2", "4", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,few,many,other;other", "cs", []string{"1", "2", "3", "4", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"zero,one,two,few,many,other;zero,one,two,few,many,other", "cy", []string{"1", "1.0", "1.00", "1.000", "1.0000", "2", "2.0", "2.00", "2.000", "2.0000", "3", "3.0", "3.00", "3.000", "3.0000", "6", "6.0", "6.00", "6.000", "6.0000", "0", "0.0", "0.00", "0.000", "0.0000", "4", "5", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "2", "3", "4", "5", "6", "0", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,two,few,other;other", "dsb", []string{"1", "101", "201", "301", "401", "501", "601", "701", "1001", "0.1", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1", "2", "102", "202", "302", "402", "502", "602", "702", "1002", "0.2", "1.2", "2.2", "3.2", "4.2", "5.2", "6.2", "7.2", "10.2", "100.2", "1000.2", "3", "4", "103", "104", "203", "204", "303", "304", "403", "404", "503", "504", "603", "604", "703", "704", "1003", "0.3", "0.4", "1.3", "1.4", "2.3", "2.4", "3.3", "3.4", "4.3", "4.4", "5.3", "5.4", "6.3", "6.4", "7.3", "7.4", "10.3", "100.3", "1000.3", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.5", "2.6", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,two,few,other", "en", []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "2", "22", "32", "42", "52", "62", "72", "82", "102", "1002", "3", "23", "33", "43", "53", "63", "73", "83", "103", "1003", "0", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,other", "fil", []string{"0", "1", "2", "3", "5", "7", "8", "10", "11", "12", "13", "15", "17", "18", "20", "21", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.5", "0.7", "0.8", "1.0", "1.1", "1.2", "1.3", "1.5", "1.7", "1.8", "2.0", "2.1", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "4", "6", "9", "14", "16", "19", "24", "26", "104", "1004", "0.4", "0.6", "0.9", "1.4", "1.6", "1.9", "2.4", "2.6", "10.4", "100.4", "1000.4"}, []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,other", "fr", []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,two,few,many,other;one,other", "ga", []string{"1", "1.0", "1.00", "1.000", "1.0000", "2", "2.0", "2.00", "2.000", "2.0000", "3", "4", "5", "6", "3.0", "4.0", "5.0", "6.0", "3.00", "4.00", "5.00", "6.00", "3.000", "4.000", "5.000", "6.000", "3.0000", "4.0000", "5.0000", "6.0000", "7", "8", "9", "10", "7.0", "8.0", "9.0", "10.0", "7.00", "8.00", "9.00", "10.00", "7.000", "8.000", "9.000", "10.000", "7.0000", "8.0000", "9.0000", "10.0000", "0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,two,few,other;one,two,few,other", "gd", []string{"1", "11", "1.0", "11.0", "1.00", "11.00", "1.000", "11.000", "1.0000", "2", "12", "2.0", "12.0", "2.00", "12.00", "2.000", "12.000", "2.0000", "3", "4", "5", "6", "7", "8", "9", "10", "13", "14", "15", "16", "17", "18", "19", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "19.0", "3.00", "0", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "11", "2", "12", "3", "13", "0", "4", "5", "6", "7", "8", "9", "10", "14", "15", "16", "17", "18", "19", "20", "21", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,two,few,many,other", "gu", []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "2", "3", "4", "6", "0", "5", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,two,few,many,other;other", "gv", []string{"1", "11", "21", "31", "41", "51", "61", "71", "101", "1001", "2", "12", "22", "32", "42", "52", "62", "72", "102", "1002", "0", "20", "40", "60", "80", "100", "120", "140", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "3", "4", "5", "6", "7", "8", "9", "10", "13", "14", "15", "16", "17", "18", "19", "23", "103", "1003"}, nil},
+	{"one,two,many,other;other", "he", []string{"1", "2", "20", "30", "40", "50", "60", "70", "80", "90", "100", "1000", "10000", "100000", "1000000", "0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "101", "1001", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,other", "hu", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "5", "0", "2", "3", "4", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;many,other", "it", []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"8", "11", "80", "800", "0", "1", "2", "3", "4", "5", "6", "7", "9", "10", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,two,other;other", "iu", []string{"1", "1.0", "1.00", "1.000", "1.0000", "2", "2.0", "2.00", "2.000", "2.0000", "0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"one,other;one,many,other", "ka", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "102", "1002", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "36", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;many,other", "kk", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"6", "9", "10", "16", "19", "20", "26", "29", "30", "36", "39", "40", "100", "1000", "10000", "100000", "1000000", "0", "1", "2", "3", "4", "5", "7", "8", "11", "12", "13", "14", "15", "17", "18", "21", "101", "1001"}},
+	{"zero,one,other;other", "ksh", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "0.0", "0.00", "0.000", "0.0000", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"zero,one,two,few,many,other;one,many,other", "kw", []string{"1", "1.0", "1.00", "1.000", "1.0000", "2", "22", "42", "62", "82", "102", "122", "142", "1002", "2.0", "22.0", "42.0", "62.0", "82.0", "102.0", "122.0", "142.0", "1002.0", "3", "23", "43", "63", "83", "103", "123", "143", "1003", "3.0", "23.0", "43.0", "63.0", "83.0", "103.0", "123.0", "143.0", "1003.0", "21", "41", "61", "81", "101", "121", "141", "161", "1001", "21.0", "41.0", "61.0", "81.0", "101.0", "121.0", "141.0", "161.0", "1001.0", "0", "0.0", "0.00", "0.000", "0.0000", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1004", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.1", "1000000.0"}, []string{"1", "2", "3", "4", "21", "22", "23", "24", "41", "42", "43", "44", "61", "62", "63", "64", "101", "1001", "5", "105", "205", "305", "405", "505", "605", "705", "1005", "0", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"}},
+	{"zero,one,other;other", "lag", []string{"1", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "0", "0.0", "0.00", "0.000", "0.0000", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"other;one,other", "lo", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,few,many,other;other", "lt", []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "1.0", "21.0", "31.0", "41.0", "51.0", "61.0", "71.0", "81.0", "101.0", "1001.0", "2", "3", "4", "5", "6", "7", "8", "9", "22", "23", "24", "25", "26", "27", "28", "29", "102", "1002", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "22.0", "102.0", "1002.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.1", "1000.1", "0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000", "0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"zero,one,other;other", "lv", []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.0", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1", "0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000", "0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "2", "3", "4", "5", "6", "7", "8", "9", "22", "23", "24", "25", "26", "27", "28", "29", "102", "1002", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "10.2", "100.2", "1000.2"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,two,many,other", "mk", []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "2", "22", "32", "42", "52", "62", "72", "82", "102", "1002", "7", "8", "27", "28", "37", "38", "47", "48", "57", "58", "67", "68", "77", "78", "87", "88", "107", "1007", "0", "3", "4", "5", "6", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,few,other;one,other", "mo", []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "102", "1002", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "100", "1000", "10000", "100000", "1000000"}, []string{"1", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,two,few,other", "mr", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "2", "3", "4", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,few,many,other;other", "mt", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "102", "103", "104", "105", "106", "107", "1002", "0.0", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "10.0", "102.0", "1002.0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "111", "112", "113", "114", "115", "116", "117", "1011", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"one,other;one,two,few,many,other", "or", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "5", "7", "8", "9", "2", "3", "4", "6", "0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,few,other;other", "shi", []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04", "2", "3", "4", "5", "6", "7", "8", "9", "10", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "2.00", "3.00", "4.00", "5.00", "6.00", "7.00", "8.00", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "100", "1000", "10000", "100000", "1000000", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, nil},
+	{"one,two,few,other;other", "sl", []string{"1", "101", "201", "301", "401", "501", "601", "701", "1001", "2", "102", "202", "302", "402", "502", "602", "702", "1002", "3", "4", "103", "104", "203", "204", "303", "304", "403", "404", "503", "504", "603", "604", "703", "704", "1003", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"}, []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;one,many,other", "sq", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"1", "4", "24", "34", "44", "54", "64", "74", "84", "104", "1004", "0", "2", "3", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,other;few,other", "tk", []string{"1", "1.0", "1.00", "1.000", "1.0000", "0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"6", "9", "10", "16", "19", "26", "29", "36", "39", "106", "1006", "0", "1", "2", "3", "4", "5", "7", "8", "11", "12", "13", "14", "15", "17", "18", "20", "100", "1000", "10000", "100000", "1000000"}},
+	{"one,few,many,other;few,other", "uk", []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002", "0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"}, []string{"3", "23", "33", "43", "53", "63", "73", "83", "103", "1003", "0", "1", "2", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"}},
+}
+
+func BenchmarkPluralFunc(b *testing.B) {
+	for _, bench := range pluralBenchmarks {
+		bench := bench
+		b.Run(bench.class+"/"+bench.culture, func(b *testing.B) {
+			benchmarkOperands(b, language.MustParse(bench.culture), bench.cardinals, bench.ordinals)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package httpplural negotiates the plural rules of HTTP requests from their
+// Accept-Language header, handing them to handlers through the request
+// context:
+//
+//	http.Handle("/", httpplural.Middleware(handler))
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//		s, _ := httpplural.FromContext(r.Context())
+//		s.Form(3, false) // "few" for "Accept-Language: ru"
+//	}
+package httpplural
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// Selector holds the plural rules negotiated for a request.
+type Selector struct {
+	// Tag is the supported language whose rules are used.
+	Tag language.Tag
+	// Confidence tells how well Tag matches the request, No when the
+	// fallback language is used.
+	Confidence language.Confidence
+
+	fn    func(plural.Operands, bool) plural.Category
+	value func(interface{}, bool) string
+}
+
+// Category returns the plural category of o.
+func (s *Selector) Category(o plural.Operands, ordinal bool) plural.Category {
+	return s.fn(o, ordinal)
+}
+
+// Form returns the name of the plural category of value, which is any type
+// plural.GetFunc accepts.
+func (s *Selector) Form(value interface{}, ordinal bool) string {
+	return s.value(value, ordinal)
+}
+
+// Negotiator picks the plural rules of requests among the languages of a
+// registry.
+type Negotiator struct {
+	registry *plural.Registry
+	// tags are the supported languages, the fallback first.
+	tags    []language.Tag
+	matcher language.Matcher
+}
+
+// NewNegotiator returns a Negotiator of the given languages, whose rules are
+// those of r. fallback is used for requests matching none of them, its rules
+// must be in r.
+func NewNegotiator(r *plural.Registry, langs []string, fallback language.Tag) (*Negotiator, error) {
+	if _, err := r.GetOperandsFunc(fallback); nil != err {
+		return nil, err
+	}
+
+	tags := make([]language.Tag, 1, len(langs)+1)
+	tags[0] = fallback
+	for _, lang := range langs {
+		tag, err := language.Parse(lang)
+		if nil != err {
+			return nil, err
+		}
+		// und, CLDR "root", would match any language
+		if language.Und != tag && fallback != tag {
+			tags = append(tags, tag)
+		}
+	}
+	return &Negotiator{r, tags, language.NewMatcher(tags)}, nil
+}
+
+var (
+	defaultNegotiator     *Negotiator
+	defaultNegotiatorOnce sync.Once
+)
+
+// Default returns the Negotiator of the languages of plural.Info, using
+// plural.Default, falling back to English.
+func Default() *Negotiator {
+	defaultNegotiatorOnce.Do(func() {
+		var err error
+		defaultNegotiator, err = NewNegotiator(plural.Default, plural.Info.Langs(), language.English)
+		if nil != err {
+			panic(err)
+		}
+	})
+	return defaultNegotiator
+}
+
+// Negotiate returns the Selector of the best supported language for an
+// Accept-Language header, the fallback one when none matches or the header
+// does not parse.
+func (n *Negotiator) Negotiate(acceptLanguage string) *Selector {
+	index, confidence := 0, language.No
+	if desired, _, err := language.ParseAcceptLanguage(acceptLanguage); nil == err && 0 != len(desired) {
+		_, index, confidence = n.matcher.Match(desired...)
+	}
+
+	tag := n.tags[index]
+	fn, err := n.registry.GetOperandsFunc(tag)
+	if nil != err {
+		// unregistered, or left out by a reload, since the negotiator was
+		// built
+		tag, confidence = n.tags[0], language.No
+		if fn, err = n.registry.GetOperandsFunc(tag); nil != err {
+			fn = func(plural.Operands, bool) plural.Category { return plural.Other }
+		}
+	}
+	value, err := n.registry.GetFunc(tag)
+	if nil != err {
+		value = func(interface{}, bool) string { return plural.Other.String() }
+	}
+	return &Selector{tag, confidence, fn, value}
+}
+
+// Middleware stores the Selector negotiated for each request in its context,
+// read by FromContext.
+func (n *Negotiator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s := n.Negotiate(r.Header.Get("Accept-Language"))
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), s)))
+	})
+}
+
+// Middleware stores the Selector negotiated by Default for each request in
+// its context.
+func Middleware(next http.Handler) http.Handler {
+	return Default().Middleware(next)
+}
+
+type contextKey struct{}
+
+// NewContext returns a context holding s.
+func NewContext(ctx context.Context, s *Selector) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext returns the Selector stored by Middleware, false when ctx has
+// none.
+func FromContext(ctx context.Context) (*Selector, bool) {
+	s, ok := ctx.Value(contextKey{}).(*Selector)
+	return s, ok
+}
@@ -0,0 +1,92 @@
+package httpplural
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+func TestMiddleware(t *testing.T) {
+	var got *Selector
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		if got, ok = FromContext(r.Context()); !ok {
+			t.Errorf("expecting a Selector in the context")
+		}
+	}))
+
+	tests := []struct {
+		header     string
+		tag        string
+		value      interface{}
+		expected   string
+		confidence language.Confidence
+	}{
+		{"ru", "ru", 3, "few", language.Exact},
+		{"fr-CH, fr;q=0.9, en;q=0.8", "fr", 1, "one", language.High},
+		{"pt-BR", "pt", "0.5", "one", language.Exact},
+		{"ain", "en", 1, "one", language.No},
+		{"qu", "es", 1, "one", language.High},
+		{"", "en", 2, "other", language.No},
+		{";;;", "en", 1, "one", language.No},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.Header.Set("Accept-Language", test.header)
+		got = nil
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+
+		if nil == got {
+			continue
+		}
+		if tag := language.MustParse(test.tag); tag != got.Tag {
+			t.Errorf("`%s` expecting <%s> but got <%s>", test.header, tag, got.Tag)
+		}
+		if got.Confidence < test.confidence || (language.No == test.confidence && language.No != got.Confidence) {
+			t.Errorf("`%s` expecting confidence %s but got %s", test.header, test.confidence, got.Confidence)
+		}
+		if result := got.Form(test.value, false); result != test.expected {
+			t.Errorf("`%s` Form(%v) expecting <%s> but got <%s>", test.header, test.value, test.expected, result)
+		}
+	}
+
+	if _, ok := FromContext(httptest.NewRequest("GET", "/", nil).Context()); ok {
+		t.Errorf("expecting no Selector without the middleware")
+	}
+}
+
+func TestNegotiator(t *testing.T) {
+	r, err := plural.NewRegistry(&plural.Info)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	pirate := language.MustParse("en-x-pirate")
+	if err := r.RegisterRules(pirate, map[string]string{"many": "n = 7", "other": ""}, nil); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	n, err := NewNegotiator(r, []string{"en-x-pirate", "de"}, language.German)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if s := n.Negotiate("en-x-pirate, de;q=0.5"); pirate != s.Tag || plural.Many != s.Category(plural.IntOperands(7), false) {
+		t.Errorf("expecting <many> of `%s` but got <%s> of `%s`", pirate, s.Category(plural.IntOperands(7), false), s.Tag)
+	}
+	if s := n.Negotiate("ja"); language.German != s.Tag || language.No != s.Confidence {
+		t.Errorf("expecting the fallback `de` but got `%s` %s", s.Tag, s.Confidence)
+	}
+
+	// rules unregistered after the negotiator was built
+	r.Unregister(pirate)
+	if s := n.Negotiate("en-x-pirate"); language.German != s.Tag || language.No != s.Confidence {
+		t.Errorf("expecting the fallback `de` but got `%s` %s", s.Tag, s.Confidence)
+	}
+
+	if _, err := NewNegotiator(r, nil, pirate); nil == err {
+		t.Errorf("expecting an error for a fallback without rules")
+	}
+}
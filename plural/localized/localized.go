@@ -0,0 +1,171 @@
+// Package localized turns numbers written with the symbols of a locale, such
+// as "1,5" in German, "1 234,50" in French or "١٢٣" in Arabic, into plural
+// operands.
+//
+// It is kept apart from the plural package as it relies on the number
+// formatting data of golang.org/x/text.
+package localized
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// Format holds the symbols a locale writes numbers with.
+type Format struct {
+	Tag language.Tag
+
+	// Decimal is the decimal separator.
+	Decimal rune
+
+	// Group is the grouping separator.
+	Group rune
+
+	// Digits are the digits of the locale numbering system.
+	Digits [10]rune
+}
+
+// NewFormat returns the symbols of the default numbering system of tag, or of
+// the one given by its "nu" extension.
+func NewFormat(tag language.Tag) *Format {
+	p := message.NewPrinter(tag)
+
+	f := &Format{Tag: tag}
+	for d := range f.Digits {
+		f.Digits[d], _ = utf8.DecodeRuneInString(p.Sprint(number.Decimal(d)))
+	}
+
+	// 1234567.5 is written 1<group>234<group>567<decimal>5, some locales
+	// not grouping numbers below 10000.
+	for _, r := range p.Sprint(number.Decimal(1234567.5, number.MinFractionDigits(1))) {
+		switch {
+		case f.digit(r) >= 0 || unicode.Is(unicode.Cf, r):
+		case 0 == f.Group:
+			f.Group = r
+		case r != f.Group:
+			f.Decimal = r
+		}
+	}
+	if 0 == f.Decimal {
+		f.Decimal, f.Group = f.Group, 0
+	}
+	return f
+}
+
+var formats sync.Map
+
+// FormatOf returns the symbols of tag, as NewFormat does, caching them.
+func FormatOf(tag language.Tag) *Format {
+	if f, ok := formats.Load(tag); ok {
+		return f.(*Format)
+	}
+	f, _ := formats.LoadOrStore(tag, NewFormat(tag))
+	return f.(*Format)
+}
+
+// digit returns the value of r, ASCII digits being always accepted, or -1.
+func (f *Format) digit(r rune) int {
+	if r >= '0' && r <= '9' {
+		return int(r - '0')
+	}
+	for d, digit := range f.Digits {
+		if r == digit {
+			return d
+		}
+	}
+	return -1
+}
+
+// isGroup tells whether r separates groups. Any space is accepted when the
+// locale groups with a no-break space, and an apostrophe for a right single
+// quotation mark, as they are what users type.
+func (f *Format) isGroup(r rune) bool {
+	switch {
+	case r == f.Group:
+		return true
+	case unicode.IsSpace(f.Group):
+		return unicode.IsSpace(r)
+	case '’' == f.Group:
+		return '\'' == r
+	}
+	return false
+}
+
+// ParseOperands returns the operands of s written with the symbols of f, in
+// the grammar accepted by plural.ParseOperands. Grouping separators are only
+// allowed between digits of the integer part, and bidi marks are ignored.
+func (f *Format) ParseOperands(s string) (plural.Operands, error) {
+	var buf [64]byte
+	b := buf[:0]
+
+	var fraction, digit, group bool
+	for _, r := range strings.TrimSpace(s) {
+		if unicode.Is(unicode.Cf, r) {
+			continue
+		}
+
+		if f.isGroup(r) && r != f.Decimal {
+			if !digit || fraction {
+				return plural.Operands{}, plural.ErrInvalidNumber
+			}
+			digit, group = false, true
+			continue
+		}
+
+		d := f.digit(r)
+		if group && d < 0 {
+			return plural.Operands{}, plural.ErrInvalidNumber
+		}
+		digit, group = d >= 0, false
+
+		switch {
+		case d >= 0:
+			b = append(b, byte('0'+d))
+		case r == f.Decimal:
+			b = append(b, '.')
+			fraction = true
+		case '-' == r || '−' == r:
+			b = append(b, '-')
+		case '+' == r || 'e' == r || 'E' == r:
+			b = append(b, byte(r))
+			fraction = fraction || 'e' == r || 'E' == r
+		default:
+			return plural.Operands{}, plural.ErrInvalidNumber
+		}
+	}
+	if group {
+		return plural.Operands{}, plural.ErrInvalidNumber
+	}
+	return plural.ParseOperands(string(b))
+}
+
+// ParseOperands returns the operands of s written with the symbols of tag.
+func ParseOperands(tag language.Tag, s string) (plural.Operands, error) {
+	return FormatOf(tag).ParseOperands(s)
+}
+
+// GetFunc returns the plural function of culture, taking numbers written with
+// its symbols. It returns plural.ErrInvalidNumber for strings it cannot parse.
+func GetFunc(culture language.Tag) (func(s string, ordinal bool) (plural.Category, error), error) {
+	fn, err := plural.GetOperandsFunc(culture)
+	if nil != err {
+		return nil, err
+	}
+
+	f := FormatOf(culture)
+	return func(s string, ordinal bool) (plural.Category, error) {
+		o, err := f.ParseOperands(s)
+		if nil != err {
+			return plural.Other, err
+		}
+		return fn(o, ordinal), nil
+	}, nil
+}
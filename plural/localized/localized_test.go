@@ -0,0 +1,116 @@
+package localized
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+func TestNewFormat(t *testing.T) {
+	tests := []struct {
+		culture        string
+		decimal, group rune
+		zero           rune
+	}{
+		{"en", '.', ',', '0'},
+		{"de", ',', '.', '0'},
+		{"fr", ',', ' ', '0'},
+		{"de-CH", '.', '’', '0'},
+		{"ar", '٫', '٬', '٠'},
+		{"ar-u-nu-latn", '.', ',', '0'},
+		{"fa", '٫', '٬', '۰'},
+		{"mr", '.', ',', '०'},
+	}
+	for _, test := range tests {
+		f := NewFormat(language.MustParse(test.culture))
+		if f.Decimal != test.decimal || f.Group != test.group || f.Digits[0] != test.zero {
+			t.Errorf("`%s` expecting %q %q %q but got %q %q %q", test.culture, test.decimal, test.group, test.zero, f.Decimal, f.Group, f.Digits[0])
+		}
+	}
+}
+
+func TestParseOperands(t *testing.T) {
+	tests := []struct {
+		culture  string
+		value    string
+		expected plural.Operands
+	}{
+		{"en", "1,234.50", plural.Operands{N: 1234.5, I: 1234, V: 2, W: 1, F: 50, T: 5}},
+		{"de", "1,5", plural.Operands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5}},
+		{"de", "-1.234,0", plural.Operands{N: 1234, I: -1234, V: 1}},
+		{"fr", "1 234,50", plural.Operands{N: 1234.5, I: 1234, V: 2, W: 1, F: 50, T: 5}},
+		{"fr", "1 234,50", plural.Operands{N: 1234.5, I: 1234, V: 2, W: 1, F: 50, T: 5}},
+		{"fr", "1 234", plural.Operands{N: 1234, I: 1234}},
+		{"de-CH", "1'234.5", plural.Operands{N: 1234.5, I: 1234, V: 1, W: 1, F: 5, T: 5}},
+		{"ar", "١٬٢٣٤٫٥", plural.Operands{N: 1234.5, I: 1234, V: 1, W: 1, F: 5, T: 5}},
+		{"ar", "؜-٣", plural.Operands{N: 3, I: -3}},
+		{"ar", "12", plural.Operands{N: 12, I: 12}},
+		{"fa", "۱۲٫۵۰", plural.Operands{N: 12.5, I: 12, V: 2, W: 1, F: 50, T: 5}},
+		{"mr", "१२,३४,५६७", plural.Operands{N: 1234567, I: 1234567}},
+		{"en", "1.5E-2", plural.Operands{N: 0.015, V: 3, W: 3, F: 15, T: 15}},
+	}
+	for _, test := range tests {
+		o, err := ParseOperands(language.MustParse(test.culture), test.value)
+		if nil != err {
+			t.Errorf("`%s` `%s` unexpected error: %s", test.culture, test.value, err.Error())
+			continue
+		}
+		if o != test.expected {
+			t.Errorf("`%s` `%s` expecting %+v but got %+v", test.culture, test.value, test.expected, o)
+		}
+	}
+
+	invalids := []struct {
+		culture string
+		value   string
+	}{
+		{"en", ""},
+		{"en", ",1"},
+		{"en", "1,"},
+		{"en", "1,,2"},
+		{"en", "1.2,3"},
+		{"en", "1,5e1,0"},
+		{"de", "1,2,3"},
+		{"en", "12 000"},
+		{"ar", "١x"},
+	}
+	for _, test := range invalids {
+		if _, err := ParseOperands(language.MustParse(test.culture), test.value); err != plural.ErrInvalidNumber {
+			t.Errorf("`%s` `%s` expecting ErrInvalidNumber but got %v", test.culture, test.value, err)
+		}
+	}
+}
+
+func TestGetFunc(t *testing.T) {
+	tests := []struct {
+		culture  string
+		value    string
+		ordinal  bool
+		expected plural.Category
+	}{
+		{"de", "1", false, plural.One},
+		{"de", "1,0", false, plural.Other},
+		{"fr", "1,5", false, plural.One},
+		{"fr", "2 000,5", false, plural.Other},
+		{"ru", "21", false, plural.One},
+		{"ar", "٣", false, plural.Few},
+		{"ar", "١٠٠", false, plural.Other},
+		{"en", "1,002", true, plural.Two},
+	}
+	for _, test := range tests {
+		fn, err := GetFunc(language.MustParse(test.culture))
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		result, err := fn(test.value, test.ordinal)
+		if nil != err {
+			t.Errorf("`%s` `%s` unexpected error: %s", test.culture, test.value, err.Error())
+			continue
+		}
+		if result != test.expected {
+			t.Errorf("`%s` fn(%q, %v) expecting <%s> but got <%s>", test.culture, test.value, test.ordinal, test.expected, result)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package plural
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// ErrNoOrdinal is returned by GetOrdinalFunc for cultures CLDR has no ordinal
+// data for.
+var ErrNoOrdinal = errors.New("NoOrdinal")
+
+// GetFunc returns the plural function of the given culture, which accepts
+// int, int64, float64 and decimal string values and returns the name of the
+// category. Cultures registered at runtime come first, then the generated
+// closures, or the compact rule tables with the plural_compact build tag.
+//
+// CLDR ordinals are only defined for integers: with ordinal set, numbers
+// having a non-zero fraction such as "2.5" are "other", while "2.0" is read as
+// 2. Use OrdinalOperands to reject them instead.
+func GetFunc(culture language.Tag) (func(interface{}, bool) string, error) {
+	return Default.GetFunc(culture)
+}
+
+// GetOperandsFunc returns the plural function of the given culture taking
+// operands, looked up as GetFunc does. The generated functions do not
+// allocate.
+func GetOperandsFunc(culture language.Tag) (func(Operands, bool) Category, error) {
+	return Default.GetOperandsFunc(culture)
+}
+
+// CardinalFunc returns the plural category of a number indicating a quantity.
+type CardinalFunc func(o Operands) Category
+
+// OrdinalFunc returns the plural category of a number indicating a position
+// (first, second, etc.), which is Other for non-integers.
+type OrdinalFunc func(o Operands) Category
+
+// GetCardinalFunc returns the cardinal plural function of the given culture.
+func GetCardinalFunc(culture language.Tag) (CardinalFunc, error) {
+	return Default.GetCardinalFunc(culture)
+}
+
+// GetOrdinalFunc returns the ordinal plural function of the given culture,
+// or an error wrapping ErrNoOrdinal when CLDR has no ordinal data for it.
+func GetOrdinalFunc(culture language.Tag) (OrdinalFunc, error) {
+	return Default.GetOrdinalFunc(culture)
+}
+
+// GetFunc returns the plural function of the given culture, as the
+// package-level GetFunc does with the rules of r.
+func (r *Registry) GetFunc(culture language.Tag) (func(interface{}, bool) string, error) {
+	fn, _, ok := r.resolve(culture, false)
+	if !ok {
+		return nil, fmt.Errorf("UnknownCulture: `%s`", culture)
+	}
+	return fn.stringFunc(), nil
+}
+
+// GetOperandsFunc returns the plural function of the given culture taking
+// operands.
+func (r *Registry) GetOperandsFunc(culture language.Tag) (func(Operands, bool) Category, error) {
+	fn, _, ok := r.resolve(culture, false)
+	if !ok {
+		return nil, fmt.Errorf("UnknownCulture: `%s`", culture)
+	}
+	return fn.operandsFunc(), nil
+}
+
+// GetCardinalFunc returns the cardinal plural function of the given culture.
+func (r *Registry) GetCardinalFunc(culture language.Tag) (CardinalFunc, error) {
+	fn, _, ok := r.resolve(culture, false)
+	if !ok {
+		return nil, fmt.Errorf("UnknownCulture: `%s`", culture)
+	}
+	return fn.cardinal, nil
+}
+
+// GetOrdinalFunc returns the ordinal plural function of the given culture,
+// or an error wrapping ErrNoOrdinal when it has no ordinal rules.
+func (r *Registry) GetOrdinalFunc(culture language.Tag) (OrdinalFunc, error) {
+	fn, _, ok := r.resolve(culture, false)
+	if !ok {
+		return nil, fmt.Errorf("UnknownCulture: `%s`", culture)
+	}
+	if nil == fn.ordinal {
+		return nil, fmt.Errorf("%w: `%s`", ErrNoOrdinal, culture)
+	}
+	return func(o Operands) Category { return fn.eval(o, true) }, nil
+}
@@ -0,0 +1,125 @@
+package plural
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestGetCardinalFunc(t *testing.T) {
+	for culture, expected := range map[string]Category{"en": One, "ak": One, "ru": One, "ja": Other, "no": One, "tw": One} {
+		fn, err := GetCardinalFunc(language.MustParse(culture))
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", culture, err.Error())
+			continue
+		}
+		if result := fn(IntOperands(1)); result != expected {
+			t.Errorf("`%s` fn(1) expecting <%s> but got <%s>", culture, expected, result)
+		}
+	}
+
+	if _, err := GetCardinalFunc(language.MustParse("en-US")); nil == err {
+		t.Errorf("`en-US` expecting an error")
+	}
+}
+
+func TestGetOrdinalFunc(t *testing.T) {
+	tests := []struct {
+		culture  string
+		value    int64
+		expected Category
+	}{
+		{"en", 1, One},
+		{"en", 22, Two},
+		{"en", 13, Other},
+		{"fr", 1, One},
+		{"ja", 1, Other},
+		{"zh", 2, Other},
+	}
+	for _, test := range tests {
+		fn, err := GetOrdinalFunc(language.MustParse(test.culture))
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", test.culture, err.Error())
+			continue
+		}
+		if result := fn(IntOperands(test.value)); result != test.expected {
+			t.Errorf("`%s` fn(%d) expecting <%s> but got <%s>", test.culture, test.value, test.expected, result)
+		}
+	}
+
+	for _, culture := range []string{"ak", "asa", "bm"} {
+		if _, err := GetOrdinalFunc(language.MustParse(culture)); !errors.Is(err, ErrNoOrdinal) {
+			t.Errorf("`%s` expecting ErrNoOrdinal but got %v", culture, err)
+		}
+	}
+}
+
+func TestNoOrdinalIsOther(t *testing.T) {
+	fn, err := GetFunc(language.MustParse("ak"))
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if result := fn(1, true); "other" != result {
+		t.Errorf("`ak` fn(1, true) expecting <other> but got <%s>", result)
+	}
+}
+
+// The CLDR language aliases resolve to the rules of their replacement,
+// whether the tag keeps the legacy code, as with language.Raw, or is
+// canonicalized by golang.org/x/text, "mo" being "ro-MD".
+func TestGetFuncAliases(t *testing.T) {
+	for _, code := range []string{"no", "iw", "tl", "sh", "mo", "in", "jw", "ji"} {
+		if _, ok := Info.Aliases[code]; !ok {
+			t.Errorf("`%s` expecting a language alias", code)
+		}
+	}
+
+	values := []interface{}{"0.5", "1.0", "2.25"}
+	for i := 0; i <= 120; i++ {
+		values = append(values, i)
+	}
+	for code, replacement := range Info.Aliases {
+		expected, err := GetFunc(language.MustParse(replacement))
+		if nil != err {
+			t.Errorf("`%s` replacement `%s` unexpected error: %s", code, replacement, err.Error())
+			continue
+		}
+		for _, parse := range []func(string) (language.Tag, error){language.Raw.Parse, language.Parse} {
+			tag, err := parse(code)
+			if nil != err {
+				t.Errorf("`%s` unexpected error: %s", code, err.Error())
+				continue
+			}
+			fn, err := GetFunc(tag)
+			if nil != err {
+				t.Errorf("`%s` (%s) unexpected error: %s", code, tag, err.Error())
+				continue
+			}
+			for _, ordinal := range []bool{false, true} {
+				for _, value := range values {
+					if result := fn(value, ordinal); expected(value, ordinal) != result {
+						t.Errorf("`%s` (%s) fn(%v, %t) expecting <%s> but got <%s>", code, tag, value, ordinal, expected(value, ordinal), result)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestGetFuncConcurrent looks up several locales at once: run alone with
+// -race, the lookups race to build the registry.
+func TestGetFuncConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for _, tag := range []language.Tag{language.English, language.Russian, language.Arabic, language.Japanese} {
+		wg.Add(1)
+		go func(tag language.Tag) {
+			defer wg.Done()
+			if _, err := GetFunc(tag); err != nil {
+				t.Errorf("Unexpected error: %s", err.Error())
+			}
+		}(tag)
+	}
+	wg.Wait()
+}
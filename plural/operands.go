@@ -0,0 +1,333 @@
+package plural
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidNumber is returned when a value cannot be turned into operands.
+var ErrInvalidNumber = errors.New("InvalidNumber")
+
+// ErrInvalidOrdinal is returned for ordinals that are not integers.
+var ErrInvalidOrdinal = errors.New("InvalidOrdinal")
+
+// bigDigits is the threshold beyond which I, F and T only keep their last 18
+// digits, see Operands.
+const bigDigits = 1000000000000000000
+
+// Operands are the plural operands of a number.
+//
+// I, F and T are exact below 10^18. Beyond, they hold 10^18 plus their last
+// 18 digits, so that they still compare greater than any rule constant and
+// their remainders modulo 10, 100, 1000... stay exact.
+//
+// @see http://unicode.org/reports/tr35/tr35-numbers.html#Operands
+type Operands struct {
+	// N is the absolute value of the source number (integer and decimals).
+	N float64
+
+	// I is the integer digits of n.
+	I int64
+
+	// V is the number of visible fraction digits in n, with trailing zeros.
+	V int
+
+	// W is the number of visible fraction digits in n, without trailing zeros.
+	W int
+
+	// F is the visible fractional digits in n, with trailing zeros.
+	F int64
+
+	// T is the visible fractional digits in n, without trailing zeros.
+	T int64
+
+	// E is the exponent of the compact decimal notation, 6 for "1.2c6",
+	// zero otherwise. CLDR names it both e and c.
+	E int
+}
+
+// IntOperands returns the operands of an integer.
+func IntOperands(i int64) Operands {
+	o := Operands{N: math.Abs(float64(i)), I: i}
+	if i <= -bigDigits || i >= bigDigits {
+		o.I = i % bigDigits
+		if i < 0 {
+			o.I -= bigDigits
+		} else {
+			o.I += bigDigits
+		}
+	}
+	return o
+}
+
+// BigIntOperands returns the operands of an integer of any size.
+func BigIntOperands(i *big.Int) Operands {
+	o, err := ParseOperands(i.String())
+	if nil != err {
+		return Operands{}
+	}
+	return o
+}
+
+// BigFloatOperands returns the operands of a big float formatted with scale
+// visible fraction digits, as FloatOperands does.
+func BigFloatOperands(f *big.Float, scale int) Operands {
+	if f.IsInf() {
+		return Operands{}
+	}
+	o, err := ParseOperands(f.Text('f', scale))
+	if nil != err {
+		return Operands{}
+	}
+	return o
+}
+
+// FloatOperands returns the operands of a float formatted with scale visible
+// fraction digits, so that FloatOperands(1.5, 2) has the operands of "1.50".
+// A negative scale uses the smallest number of digits representing the float
+// exactly. NaN, infinities and floats out of range have zero operands.
+func FloatOperands(f float64, scale int) Operands {
+	var buf [64]byte
+	o, err := ParseOperands(string(strconv.AppendFloat(buf[:0], f, 'f', scale, 64)))
+	if nil != err {
+		return Operands{}
+	}
+	return o
+}
+
+// ParseOperands returns the operands of a number string of any length, made
+// of an optional sign, digits with an optional decimal point and an optional
+// exponent, such as "-12.50", "+.5", "3." or "1.5E-2". Surrounding spaces are
+// ignored.
+//
+// The exponent moves the decimal point and the fraction digits stay visible,
+// so "1.50e1" has the operands of "15.0" and "1.5E-2" those of "0.015".
+// A "c" exponent is the compact decimal notation CLDR uses in its samples:
+// "1.2c6", 1.2 million, has the operands of "1200000" with E = 6.
+func ParseOperands(s string) (Operands, error) {
+	var o Operands
+
+	s = strings.TrimSpace(s)
+	d, ok := parseDecimal(s)
+	if !ok {
+		return o, ErrInvalidNumber
+	}
+
+	end := d.len()
+	if end < d.point {
+		end = d.point
+	}
+	last := d.len()
+	for last > d.point && '0' == d.digit(last-1) {
+		last--
+	}
+
+	o.I = d.value(0, d.point)
+	if d.neg {
+		o.I = -o.I
+	}
+	o.V = end - d.point
+	if last > d.point {
+		o.W = last - d.point
+	}
+	o.F = d.value(d.point, end)
+	o.T = d.value(d.point, d.point+o.W)
+	o.E = d.compact
+
+	if strings.IndexByte(s, 'c') >= 0 {
+		s = strings.Replace(s, "c", "e", 1)
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if nil != err && !math.IsInf(n, 0) {
+		return Operands{}, ErrInvalidNumber
+	}
+	o.N = math.Abs(n)
+	return o, nil
+}
+
+// maxExponent bounds the exponents accepted by ParseOperands.
+const maxExponent = 100000000
+
+// decimal is a number string parsed without allocating: its digits are the
+// integer digits followed by the fraction digits, the decimal point being at
+// point once moved by the exponent. Digits out of range are zeros. compact
+// is the exponent of the compact notation, "1.2c6".
+type decimal struct {
+	neg         bool
+	intd, fracd string
+	point       int
+	compact     int
+}
+
+func parseDecimal(s string) (d decimal, ok bool) {
+	i := 0
+	if i < len(s) && ('-' == s[i] || '+' == s[i]) {
+		d.neg = '-' == s[i]
+		i++
+	}
+
+	start := i
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	d.intd = s[start:i]
+
+	if i < len(s) && '.' == s[i] {
+		i++
+		start = i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		d.fracd = s[start:i]
+	}
+	if "" == d.intd && "" == d.fracd {
+		return d, false
+	}
+	d.point = len(d.intd)
+
+	if i < len(s) && ('e' == s[i] || 'E' == s[i] || 'c' == s[i]) {
+		compact := 'c' == s[i]
+		i++
+		neg := false
+		if !compact && i < len(s) && ('-' == s[i] || '+' == s[i]) {
+			neg = '-' == s[i]
+			i++
+		}
+
+		start = i
+		exp := 0
+		for i < len(s) && isDigit(s[i]) {
+			if exp = exp*10 + int(s[i]-'0'); exp > maxExponent {
+				return d, false
+			}
+			i++
+		}
+		if start == i {
+			return d, false
+		}
+
+		if neg {
+			d.point -= exp
+		} else {
+			d.point += exp
+		}
+		if compact {
+			d.compact = exp
+		}
+	}
+	return d, i == len(s)
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func (d *decimal) len() int { return len(d.intd) + len(d.fracd) }
+
+func (d *decimal) digit(k int) byte {
+	switch {
+	case k < 0 || k >= d.len():
+		return '0'
+	case k < len(d.intd):
+		return d.intd[k]
+	}
+	return d.fracd[k-len(d.intd)]
+}
+
+// value returns the number made of the digits in [from, to), reduced as
+// described by Operands beyond 10^18.
+func (d *decimal) value(from, to int) int64 {
+	var high int64
+	if to-from > 18 {
+		lo, hi := from, to-18
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > d.len() {
+			hi = d.len()
+		}
+		for k := lo; k < hi; k++ {
+			if '0' != d.digit(k) {
+				high = bigDigits
+				break
+			}
+		}
+		from = to - 18
+	}
+
+	var x int64
+	for k := from; k < to; k++ {
+		x = x*10 + int64(d.digit(k)-'0')
+	}
+	return high + x
+}
+
+// OrdinalOperands returns the operands of o as an ordinal, which CLDR only
+// defines for integers. Visible zero fraction digits are dropped, so "2.0"
+// reads as 2, and ErrInvalidOrdinal is returned for any other fraction.
+func OrdinalOperands(o Operands) (Operands, error) {
+	if 0 != o.W {
+		return Operands{}, ErrInvalidOrdinal
+	}
+	o.V, o.F = 0, 0
+	return o, nil
+}
+
+// nmod returns n % m. It is exact for integers of any size, but only keeps
+// whether the fraction is zero for numbers beyond float64 precision.
+func (o Operands) nmod(m int64) float64 {
+	if o.N < 1<<53 {
+		return math.Mod(o.N, float64(m))
+	}
+
+	i := o.I
+	if i < 0 {
+		i = -i
+	}
+	r := float64(i % m)
+	if 0 != o.W {
+		r += 0.5
+	}
+	return r
+}
+
+// operandsOf returns the operands of an int, int64, float64, decimal string,
+// *big.Int, *big.Float or Operands, or zero operands for anything else.
+func operandsOf(value interface{}) Operands {
+	switch value := value.(type) {
+	case Operands:
+		return value
+
+	case int:
+		return IntOperands(int64(value))
+
+	case int64:
+		return IntOperands(value)
+
+	case float64:
+		return FloatOperands(value, -1)
+
+	case string:
+		o, err := ParseOperands(value)
+		if nil != err {
+			return Operands{}
+		}
+		return o
+
+	case *big.Int:
+		return BigIntOperands(value)
+
+	case *big.Float:
+		return BigFloatOperands(value, -1)
+	}
+	return Operands{}
+}
+
+// valueFunc adapts a plural function taking operands to the values accepted
+// by GetFunc.
+func valueFunc(fn func(Operands, bool) Category) func(interface{}, bool) string {
+	return func(value interface{}, ordinal bool) string {
+		return fn(operandsOf(value), ordinal).String()
+	}
+}
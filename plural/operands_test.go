@@ -0,0 +1,226 @@
+package plural
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func testOperands(t *testing.T, name string, o, expected Operands) {
+	if o != expected {
+		t.Errorf("`%s` expecting %+v but got %+v", name, expected, o)
+	}
+}
+
+func TestParseOperands(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Operands
+	}{
+		{"0", Operands{}},
+		{"1", Operands{N: 1, I: 1}},
+		{"-21", Operands{N: 21, I: -21}},
+		{"+5", Operands{N: 5, I: 5}},
+		{"1.0", Operands{N: 1, I: 1, V: 1}},
+		{"1.50", Operands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5}},
+		{"-123.990", Operands{N: 123.99, I: -123, V: 3, W: 2, F: 990, T: 99}},
+		{"0.0010", Operands{N: 0.001, V: 4, W: 3, F: 10, T: 1}},
+		{"000000000000000000000012", Operands{N: 12, I: 12}},
+		{"12345678901234567891", Operands{N: 12345678901234567891, I: 1345678901234567891}},
+		{"-12345678901234567891", Operands{N: 12345678901234567891, I: -1345678901234567891}},
+		{"0.12345678901234567890100", Operands{N: 0.123456789012345678901, V: 23, W: 21, F: 1678901234567890100, T: 1456789012345678901}},
+		{" 3 ", Operands{N: 3, I: 3}},
+		{".5", Operands{N: 0.5, V: 1, W: 1, F: 5, T: 5}},
+		{"-.50", Operands{N: 0.5, V: 2, W: 1, F: 50, T: 5}},
+		{"5.", Operands{N: 5, I: 5}},
+		{"1e3", Operands{N: 1000, I: 1000}},
+		{"1.50e1", Operands{N: 15, I: 15, V: 1}},
+		{"1.5E-2", Operands{N: 0.015, V: 3, W: 3, F: 15, T: 15}},
+		{"-2.5e+3", Operands{N: 2500, I: -2500}},
+		{"1.2345e2", Operands{N: 123.45, I: 123, V: 2, W: 2, F: 45, T: 45}},
+		{"1e30", Operands{N: 1e30, I: 1000000000000000000}},
+		{"21e30", Operands{N: 21e30, I: 1000000000000000000}},
+		{"0e-3", Operands{V: 3}},
+		{"1c6", Operands{N: 1000000, I: 1000000, E: 6}},
+		{"1.2c3", Operands{N: 1200, I: 1200, E: 3}},
+		{"1.25c1", Operands{N: 12.5, I: 12, V: 1, W: 1, F: 5, T: 5, E: 1}},
+		{"5c0", Operands{N: 5, I: 5}},
+	}
+	for _, test := range tests {
+		o, err := ParseOperands(test.value)
+		if err != nil {
+			t.Errorf("`%s` unexpected error: %s", test.value, err.Error())
+			continue
+		}
+		testOperands(t, test.value, o, test.expected)
+	}
+
+	for _, value := range []string{"", "-", "+", ".", "-.", "1.2.3", "1e", "1e+", "e3", ".e3", "1e3.5", "1e1000000000", "1c", "1c-3", "1c+3", "1c3e2", "abc", "1 2", "0x10", "Inf", "NaN", "1_000", "1234567890123456789x"} {
+		if _, err := ParseOperands(value); err != ErrInvalidNumber {
+			t.Errorf("`%s` expecting ErrInvalidNumber but got %v", value, err)
+		}
+	}
+}
+
+func TestIntOperands(t *testing.T) {
+	testOperands(t, "IntOperands(0)", IntOperands(0), Operands{})
+	testOperands(t, "IntOperands(-7)", IntOperands(-7), Operands{N: 7, I: -7})
+	testOperands(t, "IntOperands(1000000)", IntOperands(1000000), Operands{N: 1000000, I: 1000000})
+	testOperands(t, "IntOperands(9223372036854775807)", IntOperands(math.MaxInt64), Operands{N: math.MaxInt64, I: 1223372036854775807})
+	testOperands(t, "IntOperands(-9223372036854775808)", IntOperands(math.MinInt64), Operands{N: -math.MinInt64, I: -1223372036854775808})
+}
+
+func TestBigOperands(t *testing.T) {
+	i, _ := new(big.Int).SetString("1000000000000000000000000000021", 10)
+	testOperands(t, "BigIntOperands(10^30+21)", BigIntOperands(i), Operands{N: 1e30, I: 1000000000000000021})
+
+	f, _ := new(big.Float).SetPrec(200).SetString("1000000000000000000000000000021.5")
+	testOperands(t, "BigFloatOperands(10^30+21.5, 2)", BigFloatOperands(f, 2), Operands{N: 1e30, I: 1000000000000000021, V: 2, W: 1, F: 50, T: 5})
+}
+
+func TestBigNumbersCategory(t *testing.T) {
+	i, _ := new(big.Int).SetString("1000000000000000000000000000021", 10)
+	tests := []struct {
+		culture  language.Tag
+		value    interface{}
+		expected string
+	}{
+		// i % 10 = 1 and i % 100 != 11
+		{language.Russian, "12345678901234567891", "one"},
+		{language.Russian, "12345678901234567811", "many"},
+		{language.Russian, i, "one"},
+		{language.Russian, "12345678901234567891.5", "other"},
+		// n % 10 = 1 and n % 100 != 11
+		{language.Latvian, "12345678901234567891", "one"},
+		{language.Latvian, "12345678901234567811", "zero"},
+		{language.Latvian, "12345678901234567891.00", "one"},
+		// v != 2 and f % 10 = 1
+		{language.Latvian, "0.100000000000000000000000000021", "one"},
+		{language.Latvian, "0.100000000000000000000000000012", "other"},
+	}
+	for _, test := range tests {
+		fn, err := GetFunc(test.culture)
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if result := fn(test.value, false); result != test.expected {
+			t.Errorf("`%s` fn(%v, false) expecting <%s> but got <%s>", test.culture, test.value, test.expected, result)
+		}
+	}
+}
+
+func TestOrdinalOperands(t *testing.T) {
+	for value, expected := range map[string]Operands{
+		"2":     {N: 2, I: 2},
+		"2.00":  {N: 2, I: 2},
+		"-11.0": {N: 11, I: -11},
+	} {
+		o, _ := ParseOperands(value)
+		o, err := OrdinalOperands(o)
+		if nil != err {
+			t.Errorf("`%s` unexpected error: %s", value, err.Error())
+			continue
+		}
+		testOperands(t, value, o, expected)
+	}
+
+	for _, value := range []string{"2.5", "0.01", "1.10"} {
+		o, _ := ParseOperands(value)
+		if _, err := OrdinalOperands(o); err != ErrInvalidOrdinal {
+			t.Errorf("`%s` expecting ErrInvalidOrdinal but got %v", value, err)
+		}
+	}
+}
+
+func TestFloatOperands(t *testing.T) {
+	testOperands(t, "FloatOperands(1.5, -1)", FloatOperands(1.5, -1), Operands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5})
+	testOperands(t, "FloatOperands(1.5, 2)", FloatOperands(1.5, 2), Operands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5})
+	testOperands(t, "FloatOperands(0, 1)", FloatOperands(0, 1), Operands{V: 1})
+	testOperands(t, "FloatOperands(2.345, 2)", FloatOperands(2.345, 2), Operands{N: 2.35, I: 2, V: 2, W: 2, F: 35, T: 35})
+	testOperands(t, "FloatOperands(-3, 0)", FloatOperands(-3, 0), Operands{N: 3, I: -3})
+}
+
+func TestOperandsNoAllocation(t *testing.T) {
+	fn, err := GetOperandsFunc(language.English)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	tests := map[string]func(){
+		"IntOperands": func() {
+			fn(IntOperands(123456789), false)
+		},
+		"FloatOperands": func() {
+			fn(FloatOperands(123456.3057892, -1), false)
+			fn(FloatOperands(1.5, 3), true)
+		},
+		"ParseOperands": func() {
+			o, _ := ParseOperands("-123456.3057000")
+			fn(o, false)
+		},
+		"ParseOperands exponent": func() {
+			o, _ := ParseOperands(" +1.5E-2 ")
+			fn(o, false)
+		},
+		"ParseOperands error": func() {
+			o, _ := ParseOperands("1e")
+			fn(o, false)
+		},
+	}
+	for name, run := range tests {
+		if allocs := testing.AllocsPerRun(100, run); allocs != 0 {
+			t.Errorf("`%s` expecting no allocation but got %v", name, allocs)
+		}
+	}
+}
+
+func FuzzParseOperands(f *testing.F) {
+	for _, seed := range []string{"0", "-21", "+5", " 3 ", "1.50", ".5", "5.", "1e3", "1.5E-2", "-2.5e+3", "0.0010", "12345678901234567891", "1e30", "1.2c3", "1.2.3", "abc"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		o, err := ParseOperands(s)
+		if nil != err {
+			if o != (Operands{}) {
+				t.Errorf("`%s` expecting zero operands on error but got %+v", s, o)
+			}
+			return
+		}
+
+		if o.V < o.W || o.W < 0 {
+			t.Errorf("`%s` expecting v >= w >= 0 but got %+v", s, o)
+		}
+		if (0 == o.W) != (0 == o.T) || 0 != o.W && 0 == o.F {
+			t.Errorf("`%s` inconsistent fraction operands %+v", s, o)
+		}
+		// the compact exponent scales n as the scientific one does
+		if n, _ := strconv.ParseFloat(strings.Replace(strings.TrimSpace(s), "c", "e", 1), 64); math.Abs(n) != o.N {
+			t.Errorf("`%s` expecting n = %v but got %v", s, math.Abs(n), o.N)
+		}
+
+		// Operands exact on 18 digits read the same once formatted back
+		// without sign nor exponent.
+		i := o.I
+		if i < 0 {
+			i = -i
+		}
+		if i >= bigDigits || o.V > 18 {
+			return
+		}
+		formatted := strconv.FormatInt(i, 10)
+		if 0 != o.V {
+			fraction := strconv.FormatInt(o.F, 10)
+			formatted += "." + strings.Repeat("0", o.V-len(fraction)) + fraction
+		}
+		expected := o
+		expected.I, expected.E = i, 0
+		if r, err := ParseOperands(formatted); nil != err || r != expected {
+			t.Errorf("`%s` formatted as `%s` expecting %+v but got %+v (%v)", s, formatted, expected, r, err)
+		}
+	})
+}
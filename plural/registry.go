@@ -0,0 +1,346 @@
+package plural
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// ErrConflict is returned by Register and RegisterRules for cultures already
+// having rules, generated or registered. Use Override to replace them.
+var ErrConflict = errors.New("Conflict")
+
+// registered are the rules of a culture, registered at runtime or built with
+// the registry.
+type registered struct {
+	cardinal CardinalFunc
+	// ordinal is nil without ordinal rules, ordinals being Other.
+	ordinal OrdinalFunc
+
+	// cardinalRules and ordinalRules are set for rules given as CLDR rules or
+	// by a PluralInfo, for Explain.
+	cardinalRules, ordinalRules *compiledRules
+
+	// operands and value are the generated functions of the cultures of
+	// Info, which evaluate without the indirections of eval.
+	operands func(Operands, bool) Category
+	value    func(interface{}, bool) string
+}
+
+func (r *registered) eval(o Operands, ordinal bool) Category {
+	if !ordinal {
+		return r.cardinal(o)
+	}
+	// ordinals are only defined for integers
+	if nil == r.ordinal || 0 != o.W {
+		return Other
+	}
+	return r.ordinal(o)
+}
+
+func (r *registered) operandsFunc() func(Operands, bool) Category {
+	if nil != r.operands {
+		return r.operands
+	}
+	return r.eval
+}
+
+func (r *registered) stringFunc() func(interface{}, bool) string {
+	if nil != r.value {
+		return r.value
+	}
+	return valueFunc(r.eval)
+}
+
+// Registry resolves cultures to their plural rules: those registered or
+// overridden at runtime first, then the rules it was built with. It is safe
+// for concurrent use, and independent of the other registries: a test or a
+// tenant may patch the rules of its own registry only.
+type Registry struct {
+	// info gives the parents of the cultures, for fallback.
+	info *PluralInfo
+
+	// build returns the base rules on first use, for the generated ones.
+	once  sync.Once
+	build func() map[language.Tag]*registered
+
+	mu sync.RWMutex
+	// base are the rules the registry was built with, funcs those
+	// registered at runtime.
+	base     map[language.Tag]*registered
+	funcs    map[language.Tag]*registered
+	fallback bool
+}
+
+// Default is the registry of the generated rules, used by the package-level
+// functions. It looks cultures up by their exact tag.
+var Default = newGeneratedRegistry()
+
+func newGeneratedRegistry() *Registry {
+	return &Registry{info: &Info, build: buildGenerated}
+}
+
+// buildGenerated returns the generated rules of every culture of Info, the
+// conditions of Explain coming from the compact rule tables.
+func buildGenerated() map[language.Tag]*registered {
+	base := make(map[language.Tag]*registered, 256)
+	for _, lang := range Info.Langs() {
+		tag := language.MustParse(lang)
+		operands, ok := generatedOperandsFunc(tag)
+		if !ok {
+			continue
+		}
+		value, _ := generatedFunc(tag)
+
+		fn := &registered{
+			cardinal: func(o Operands) Category { return operands(o, false) },
+			operands: operands,
+			value:    value,
+		}
+		if Info.HasOrdinal(tag) {
+			fn.ordinal = func(o Operands) Category { return operands(o, true) }
+		}
+		if c := Info.CulturesMap()[tag]; nil != c {
+			if rs, ok := findRuleSet(tag.String()); ok {
+				fn.cardinalRules = &compiledRules{c.Cardinal, rs.cardinal, ruleCode[:]}
+				fn.ordinalRules = &compiledRules{c.Ordinal, rs.ordinal, ruleCode[:]}
+			}
+		}
+		base[tag] = fn
+	}
+	return base
+}
+
+// NewRegistry returns a registry of the rules of info, whose conditions are
+// written as the generated ones, such as "n10 == 1 && n100 != 11". The
+// registry of Info uses the generated functions.
+func NewRegistry(info *PluralInfo) (*Registry, error) {
+	if &Info == info {
+		return newGeneratedRegistry(), nil
+	}
+
+	base := make(map[language.Tag]*registered, len(info.Cultures)+len(info.Others))
+	for i := range info.Cultures {
+		c := &info.Cultures[i]
+		cardinal, err := compileCases(c.Cardinal)
+		if nil != err {
+			return nil, fmt.Errorf("`%s` cardinal: %w", c.Langs[0], err)
+		}
+		ordinal, err := compileCases(c.Ordinal)
+		if nil != err {
+			return nil, fmt.Errorf("`%s` ordinal: %w", c.Langs[0], err)
+		}
+
+		for _, lang := range c.Langs {
+			tag, err := language.Parse(lang)
+			if nil != err {
+				return nil, fmt.Errorf("UnknownCulture: `%s`", lang)
+			}
+			fn := &registered{
+				cardinal:      func(o Operands) Category { return cardinal.form(&o) },
+				cardinalRules: cardinal,
+				ordinalRules:  ordinal,
+			}
+			if info.HasOrdinal(tag) {
+				fn.ordinal = func(o Operands) Category { return ordinal.form(&o) }
+			}
+			base[tag] = fn
+		}
+	}
+	for _, lang := range info.Others {
+		tag, err := language.Parse(lang)
+		if nil != err {
+			return nil, fmt.Errorf("UnknownCulture: `%s`", lang)
+		}
+		fn := &registered{cardinal: func(Operands) Category { return Other }}
+		if info.HasOrdinal(tag) {
+			fn.ordinal = func(Operands) Category { return Other }
+		}
+		base[tag] = fn
+	}
+	return &Registry{info: info, base: base}, nil
+}
+
+// SetFallback tells whether cultures without rules of their own use those of
+// their parent, "en-GB" using "en", as PluralInfo.Find resolves them with the
+// parents of the PluralInfo of the registry, Info for bundles. Without
+// fallback, the default, GetFunc and the others look cultures up by their
+// exact tag. Explain always falls back.
+func (r *Registry) SetFallback(fallback bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fallback = fallback
+}
+
+func (r *Registry) load() {
+	r.once.Do(func() {
+		if nil != r.build {
+			base := r.build()
+			r.mu.Lock()
+			r.base = base
+			r.mu.Unlock()
+		}
+	})
+}
+
+// resolve returns the rules of culture and the tag they were found on. Unless
+// registered for culture itself, the rules are looked up by its canonical
+// tag, "iw" by "he", as Info.Find does, then by its parents with fallback.
+func (r *Registry) resolve(culture language.Tag, fallback bool) (*registered, language.Tag, bool) {
+	r.load()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fallback = fallback || r.fallback
+	for tag, canonical := culture, false; ; {
+		if fn, ok := r.funcs[tag]; ok {
+			return fn, tag, true
+		}
+		if !canonical {
+			canonical = true
+			if alias, ok := r.info.Canonicalize(tag); ok {
+				tag = alias
+				continue
+			}
+		}
+		if fn, ok := r.base[tag]; ok {
+			return fn, tag, true
+		}
+		parent, ok := r.info.Parent(tag)
+		if !fallback || !ok {
+			return nil, culture, false
+		}
+		tag = parent
+	}
+}
+
+// set registers fn for culture. Without override, it fails for cultures
+// already having rules. Otherwise, the rules fn lacks are kept from the
+// registered or base ones.
+func (r *Registry) set(culture language.Tag, fn *registered, override bool) error {
+	r.load()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.funcs[culture]
+	if !ok {
+		current, ok = r.base[culture]
+	}
+	if !override {
+		if ok {
+			return fmt.Errorf("%w: `%s`", ErrConflict, culture)
+		}
+	} else if ok {
+		if nil == fn.cardinal {
+			fn.cardinal, fn.cardinalRules = current.cardinal, current.cardinalRules
+		}
+		if nil == fn.ordinal {
+			fn.ordinal, fn.ordinalRules = current.ordinal, current.ordinalRules
+		}
+	}
+	if nil == fn.cardinal {
+		fn.cardinal = func(Operands) Category { return Other }
+	}
+
+	if nil == r.funcs {
+		r.funcs = make(map[language.Tag]*registered)
+	}
+	r.funcs[culture] = fn
+	return nil
+}
+
+// Register adds the rules of a culture that has none, such as a private use
+// "en-x-pirate", looked up by the exact tag. A nil cardinal function makes
+// every number Other, a nil ordinal function tells there are no ordinal
+// rules, as GetOrdinalFunc reports. Ordinals having a non-zero fraction are
+// Other whatever the function.
+func (r *Registry) Register(culture language.Tag, cardinal CardinalFunc, ordinal OrdinalFunc) error {
+	return r.set(culture, &registered{cardinal: cardinal, ordinal: ordinal}, false)
+}
+
+// Override replaces the cardinal and ordinal rules of a culture with the
+// non-nil functions, keeping the others, or registers the culture.
+func (r *Registry) Override(culture language.Tag, cardinal CardinalFunc, ordinal OrdinalFunc) {
+	r.set(culture, &registered{cardinal: cardinal, ordinal: ordinal}, true)
+}
+
+// RegisterRules adds the CLDR rules of a culture that has none, read as
+// ParseCardinal and ParseOrdinal do. A nil map has the meaning of a nil
+// function for Register.
+func (r *Registry) RegisterRules(culture language.Tag, cardinal, ordinal map[string]string) error {
+	fn, err := parseRegistered(cardinal, ordinal)
+	if nil != err {
+		return err
+	}
+	return r.set(culture, fn, false)
+}
+
+// OverrideRules replaces the cardinal and ordinal rules of a culture with the
+// non-nil CLDR rules, keeping the others, or registers the culture.
+func (r *Registry) OverrideRules(culture language.Tag, cardinal, ordinal map[string]string) error {
+	fn, err := parseRegistered(cardinal, ordinal)
+	if nil != err {
+		return err
+	}
+	return r.set(culture, fn, true)
+}
+
+// Unregister removes the rules registered or overridden for a culture, the
+// rules the registry was built with applying again.
+func (r *Registry) Unregister(culture language.Tag) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.funcs, culture)
+}
+
+// Register adds the rules of a culture to Default, see Registry.Register.
+func Register(culture language.Tag, cardinal CardinalFunc, ordinal OrdinalFunc) error {
+	return Default.Register(culture, cardinal, ordinal)
+}
+
+// Override replaces the rules of a culture in Default, see
+// Registry.Override.
+func Override(culture language.Tag, cardinal CardinalFunc, ordinal OrdinalFunc) {
+	Default.Override(culture, cardinal, ordinal)
+}
+
+// RegisterRules adds the CLDR rules of a culture to Default, see
+// Registry.RegisterRules.
+func RegisterRules(culture language.Tag, cardinal, ordinal map[string]string) error {
+	return Default.RegisterRules(culture, cardinal, ordinal)
+}
+
+// OverrideRules replaces the rules of a culture in Default with CLDR rules,
+// see Registry.OverrideRules.
+func OverrideRules(culture language.Tag, cardinal, ordinal map[string]string) error {
+	return Default.OverrideRules(culture, cardinal, ordinal)
+}
+
+// Unregister removes the rules registered or overridden for a culture in
+// Default, its generated rules applying again.
+func Unregister(culture language.Tag) {
+	Default.Unregister(culture)
+}
+
+func parseRegistered(cardinal, ordinal map[string]string) (*registered, error) {
+	fn := &registered{}
+	if nil != cardinal {
+		r, err := compileRules(cardinal)
+		if nil != err {
+			return nil, fmt.Errorf("cardinal: %w", err)
+		}
+		fn.cardinal = func(o Operands) Category { return r.form(&o) }
+		fn.cardinalRules = r
+	}
+	if nil != ordinal {
+		r, err := compileRules(ordinal)
+		if nil != err {
+			return nil, fmt.Errorf("ordinal: %w", err)
+		}
+		fn.ordinal = func(o Operands) Category { return r.form(&o) }
+		fn.ordinalRules = r
+	}
+	return fn, nil
+}
@@ -0,0 +1,264 @@
+package plural
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestRegisterRules(t *testing.T) {
+	pirate := language.MustParse("en-x-pirate")
+	defer Unregister(pirate)
+
+	err := RegisterRules(pirate, map[string]string{
+		"one":   "i = 1 and v = 0 @integer 1",
+		"many":  "n = 7,77 @integer 7, 77",
+		"other": " @integer 0, 2~6",
+	}, nil)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	fn, err := GetFunc(pirate)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	for value, expected := range map[interface{}]string{1: "one", 7: "many", "77": "many", 2: "other", "1.0": "other"} {
+		if result := fn(value, false); result != expected {
+			t.Errorf("`%s` fn(%v, false) expecting <%s> but got <%s>", pirate, value, expected, result)
+		}
+	}
+	if result := fn(1, true); "other" != result {
+		t.Errorf("`%s` fn(1, true) expecting <other> but got <%s>", pirate, result)
+	}
+	if _, err := GetOrdinalFunc(pirate); !errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("`%s` expecting ErrNoOrdinal but got %v", pirate, err)
+	}
+
+	e, err := Explain(pirate, 7, false)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if e.Resolved != pirate || e.Category != Many || 1 != e.Matched || "n = 7,77" != e.Cases[1].Cond {
+		t.Errorf("`%s` 7 expecting the second case <many> but got %+v", pirate, e)
+	}
+
+	if err := RegisterRules(pirate, nil, nil); !errors.Is(err, ErrConflict) {
+		t.Errorf("`%s` expecting ErrConflict but got %v", pirate, err)
+	}
+	if err := Register(language.English, nil, nil); !errors.Is(err, ErrConflict) {
+		t.Errorf("`en` expecting ErrConflict but got %v", err)
+	}
+	if err := RegisterRules(language.MustParse("en-x-bad"), map[string]string{"one": "x = 0"}, nil); !errors.Is(err, ErrInvalidRule) {
+		t.Errorf("`en-x-bad` expecting ErrInvalidRule but got %v", err)
+	}
+}
+
+func TestOverride(t *testing.T) {
+	defer Unregister(language.English)
+
+	Override(language.English, nil, func(o Operands) Category {
+		if 1 == o.I {
+			return One
+		}
+		return Other
+	})
+
+	fn, err := GetOperandsFunc(language.English)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	tests := []struct {
+		value    int64
+		ordinal  bool
+		expected Category
+	}{
+		// cardinal rules kept from the generated ones
+		{1, false, One},
+		{2, false, Other},
+		{1, true, One},
+		{2, true, Other},
+		{21, true, Other},
+	}
+	for _, test := range tests {
+		if result := fn(IntOperands(test.value), test.ordinal); result != test.expected {
+			t.Errorf("`en` fn(%d, %v) expecting <%s> but got <%s>", test.value, test.ordinal, test.expected, result)
+		}
+	}
+
+	err = OverrideRules(language.English, map[string]string{"one": "n = 1"}, nil)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	fn, _ = GetOperandsFunc(language.English)
+	if result := fn(FloatOperands(1, 1), false); One != result {
+		t.Errorf("`en` fn(1.0, false) expecting <one> but got <%s>", result)
+	}
+	if result := fn(IntOperands(21), true); Other != result {
+		t.Errorf("`en` fn(21, true) expecting the overridden <other> but got <%s>", result)
+	}
+
+	Unregister(language.English)
+	fn, _ = GetOperandsFunc(language.English)
+	if result := fn(IntOperands(21), true); One != result {
+		t.Errorf("`en` fn(21, true) expecting the generated <one> but got <%s>", result)
+	}
+}
+
+func TestRegistryConcurrency(t *testing.T) {
+	tag := language.MustParse("fr-x-test")
+	defer Unregister(tag)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				OverrideRules(tag, map[string]string{"one": "i = 0,1"}, nil)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if fn, err := GetOperandsFunc(tag); nil == err && One != fn(IntOperands(1), false) {
+					t.Errorf("`%s` expecting <one>", tag)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRegistryIsolated(t *testing.T) {
+	r, err := NewRegistry(&Info)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	r.Override(language.Russian, func(Operands) Category { return Many }, nil)
+
+	fn, _ := r.GetOperandsFunc(language.Russian)
+	if result := fn(IntOperands(1), false); Many != result {
+		t.Errorf("`ru` registry fn(1, false) expecting <many> but got <%s>", result)
+	}
+	fn, _ = GetOperandsFunc(language.Russian)
+	if result := fn(IntOperands(1), false); One != result {
+		t.Errorf("`ru` default fn(1, false) expecting <one> but got <%s>", result)
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	info := &PluralInfo{
+		Cultures:      Info.Cultures,
+		Others:        Info.Others,
+		OrdinalOthers: Info.OrdinalOthers,
+		Aliases:       Info.Aliases,
+	}
+	r, err := NewRegistry(info)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	values := testValues()
+	for _, lang := range Info.Langs() {
+		tag := language.MustParse(lang)
+		expected, err := GetOperandsFunc(tag)
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		fn, err := r.GetOperandsFunc(tag)
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		_, hasOrdinal := GetOrdinalFunc(tag)
+		if _, err := r.GetOrdinalFunc(tag); (nil == err) != (nil == hasOrdinal) {
+			t.Errorf("`%s` ordinal expecting %v but got %v", lang, hasOrdinal, err)
+		}
+
+		for _, value := range values {
+			o, _ := ParseOperands(value)
+			for _, ordinal := range []bool{false, true} {
+				if e, result := expected(o, ordinal), fn(o, ordinal); e != result {
+					t.Errorf("`%s` fn(%s, %v) expecting <%s> but got <%s>", lang, value, ordinal, e, result)
+				}
+			}
+		}
+	}
+
+	if _, err := NewRegistry(&PluralInfo{Cultures: []Culture{{
+		Langs:    []string{"fr"},
+		Cardinal: Cases{{Form: "one", Cond: "i == 0 ||"}},
+	}}}); !errors.Is(err, ErrInvalidRule) {
+		t.Errorf("expecting ErrInvalidRule but got %v", err)
+	}
+}
+
+func TestBundleRegistry(t *testing.T) {
+	b := &Bundle{
+		Cardinal: map[string]map[string]string{"root": {"other": ""}},
+		Ordinal:  map[string]map[string]string{},
+	}
+	withOther := func(rules map[string]string) map[string]string {
+		m := map[string]string{"other": ""}
+		for key, rule := range rules {
+			m[key] = rule
+		}
+		return m
+	}
+	for _, r := range cldrRules {
+		b.Cardinal[r.culture] = withOther(r.cardinal)
+		if nil != r.ordinal {
+			b.Ordinal[r.culture] = withOther(r.ordinal)
+		}
+	}
+	r, err := NewBundleRegistry(b)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	ruUA := language.MustParse("ru-UA")
+	if _, err := r.GetFunc(ruUA); nil == err {
+		t.Errorf("`%s` expecting an error without fallback", ruUA)
+	}
+	r.SetFallback(true)
+
+	tests := []struct {
+		culture  string
+		value    interface{}
+		ordinal  bool
+		expected string
+	}{
+		{"ru-UA", 21, false, "one"},
+		{"ru", "1.5", false, "other"},
+		{"en-GB", 22, true, "two"},
+		{"mt", 12, false, "many"},
+		{"fr", 1, false, "other"},
+	}
+	for _, test := range tests {
+		fn, err := r.GetFunc(language.MustParse(test.culture))
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if result := fn(test.value, test.ordinal); result != test.expected {
+			t.Errorf("`%s` fn(%v, %v) expecting <%s> but got <%s>", test.culture, test.value, test.ordinal, test.expected, result)
+		}
+	}
+
+	if _, err := r.GetOrdinalFunc(language.Russian); !errors.Is(err, ErrNoOrdinal) {
+		t.Errorf("`ru` expecting ErrNoOrdinal but got %v", err)
+	}
+	e, err := r.Explain(ruUA, 3, false)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if language.Russian != e.Resolved || Few != e.Category || 1 != e.Matched {
+		t.Errorf("`%s` 3 expecting <few> using ru but got %+v", ruUA, e)
+	}
+
+	b.Ordinal["qu"] = map[string]string{"other": ""}
+	if _, err := NewBundleRegistry(b); !errors.Is(err, ErrNotDefined) {
+		t.Errorf("expecting ErrNotDefined but got %v", err)
+	}
+}
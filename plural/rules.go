@@ -0,0 +1,430 @@
+package plural
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrInvalidRule is returned for CLDR rules that do not parse.
+var ErrInvalidRule = errors.New("InvalidRule")
+
+// compiledRules are CLDR rules compiled at runtime into the bytecode of the
+// compact tables, their rules indexing code instead of ruleCode.
+type compiledRules struct {
+	// cases hold the CLDR conditions, samples excluded.
+	cases Cases
+	rules []rule
+	code  []instr
+}
+
+func (r *compiledRules) form(o *Operands) Category {
+	for _, x := range r.rules {
+		if o.match(r.code[x.start:x.end]) {
+			return x.form
+		}
+	}
+	return Other
+}
+
+// ParseCardinal returns the cardinal function of CLDR rules, as found in
+// plurals.json, mapping category names to rules such as
+// "one": "i = 1 and v = 0 @integer 1". The keys may also be written
+// "pluralRule-count-one", the samples are ignored and "other" is the
+// fallback, whose rule must be empty.
+func ParseCardinal(rules map[string]string) (CardinalFunc, error) {
+	r, err := compileRules(rules)
+	if nil != err {
+		return nil, err
+	}
+	return func(o Operands) Category { return r.form(&o) }, nil
+}
+
+// ParseOrdinal returns the ordinal function of CLDR rules, as found in
+// ordinals.json, written as for ParseCardinal. As for the generated
+// functions, numbers having a non-zero fraction are Other.
+func ParseOrdinal(rules map[string]string) (OrdinalFunc, error) {
+	r, err := compileRules(rules)
+	if nil != err {
+		return nil, err
+	}
+	return func(o Operands) Category {
+		if 0 != o.W {
+			return Other
+		}
+		return r.form(&o)
+	}, nil
+}
+
+func compileRules(rules map[string]string) (*compiledRules, error) {
+	conds := make(map[Category]string, len(rules))
+	for key, rule := range rules {
+		c, err := ParseCategory(strings.TrimPrefix(key, "pluralRule-count-"))
+		if nil != err {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRule, err)
+		}
+		if pos := strings.IndexByte(rule, '@'); -1 != pos {
+			rule = rule[:pos]
+		}
+		rule = strings.TrimSpace(rule)
+
+		if Other == c {
+			if "" != rule {
+				return nil, fmt.Errorf("%w: `other` must have no condition: `%s`", ErrInvalidRule, rule)
+			}
+			continue
+		}
+		if "" == rule {
+			return nil, fmt.Errorf("%w: `%s` has no condition", ErrInvalidRule, c)
+		}
+		conds[c] = rule
+	}
+
+	r := &compiledRules{}
+	for _, c := range []Category{Zero, One, Two, Few, Many} {
+		cond, ok := conds[c]
+		if !ok {
+			continue
+		}
+		n, err := newRuleParser(cond).parse()
+		if nil != err {
+			return nil, fmt.Errorf("%w: `%s`: %s", ErrInvalidRule, cond, err)
+		}
+
+		start := len(r.code)
+		code := n.compile(nil)
+		if start+len(code) > math.MaxUint16 {
+			return nil, fmt.Errorf("%w: too many conditions", ErrInvalidRule)
+		}
+		r.code = append(r.code, code...)
+		r.rules = append(r.rules, rule{c, uint16(start), uint16(len(r.code))})
+		r.cases = append(r.cases, Case{Form: c.String(), Cond: cond})
+	}
+	return r, nil
+}
+
+// ruleNode is a parsed condition: a comparison, or a conjunction or
+// disjunction of conditions.
+type ruleNode struct {
+	// op is a comparison, or opAnd or opOr for groups.
+	op       opcode
+	sym      Symbol
+	mod, arg int32
+	children []*ruleNode
+}
+
+func cmpNode(op opcode, sym Symbol, mod, arg int32) *ruleNode {
+	return &ruleNode{op: op, sym: sym, mod: mod, arg: arg}
+}
+
+func groupNode(op opcode, children ...*ruleNode) *ruleNode {
+	if 1 == len(children) {
+		return children[0]
+	}
+	return &ruleNode{op: op, children: children}
+}
+
+// compile appends the instructions of n to code, jumps being relative to the
+// first instruction of code.
+func (n *ruleNode) compile(code []instr) []instr {
+	if nil == n.children {
+		return append(code, instr{n.op, n.sym, n.mod, n.arg})
+	}
+
+	var jumps []int
+	for i, child := range n.children {
+		if 0 != i {
+			jumps = append(jumps, len(code))
+			code = append(code, instr{op: n.op})
+		}
+		code = child.compile(code)
+	}
+	for _, j := range jumps {
+		code[j].arg = int32(len(code))
+	}
+	return code
+}
+
+// ruleParser reads the condition of a CLDR rule:
+//
+//	condition = and ("or" and)*
+//	and       = relation ("and" relation)*
+//	relation  = operand ("%" value)? ("=" | "!=") range ("," range)*
+//	operand   = "n" | "i" | "v" | "w" | "f" | "t" | "e" | "c"
+//	range     = value (".." value)?
+type ruleParser struct {
+	s   string
+	pos int
+}
+
+func newRuleParser(s string) *ruleParser {
+	return &ruleParser{s: s}
+}
+
+func (p *ruleParser) parse() (*ruleNode, error) {
+	var ors []*ruleNode
+	for {
+		var ands []*ruleNode
+		for {
+			rel, err := p.relation()
+			if nil != err {
+				return nil, err
+			}
+			ands = append(ands, rel)
+			if !p.keyword("and") {
+				break
+			}
+		}
+		ors = append(ors, groupNode(opAnd, ands...))
+		if !p.keyword("or") {
+			break
+		}
+	}
+	if p.skipSpaces(); p.pos != len(p.s) {
+		return nil, fmt.Errorf("unexpected `%s`", p.s[p.pos:])
+	}
+	return groupNode(opOr, ors...), nil
+}
+
+func (p *ruleParser) skipSpaces() {
+	for p.pos < len(p.s) && ' ' == p.s[p.pos] {
+		p.pos++
+	}
+}
+
+func (p *ruleParser) keyword(word string) bool {
+	p.skipSpaces()
+	if !strings.HasPrefix(p.s[p.pos:], word+" ") {
+		return false
+	}
+	p.pos += len(word)
+	return true
+}
+
+func (p *ruleParser) token(t string) bool {
+	p.skipSpaces()
+	if !strings.HasPrefix(p.s[p.pos:], t) {
+		return false
+	}
+	p.pos += len(t)
+	return true
+}
+
+func (p *ruleParser) value() (int32, error) {
+	p.skipSpaces()
+	start := p.pos
+	var x int64
+	for p.pos < len(p.s) && isDigit(p.s[p.pos]) {
+		if x = x*10 + int64(p.s[p.pos]-'0'); x > math.MaxInt32 {
+			return 0, fmt.Errorf("value out of range at %d", start)
+		}
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, fmt.Errorf("expecting a value at %d", start)
+	}
+	return int32(x), nil
+}
+
+func (p *ruleParser) relation() (*ruleNode, error) {
+	p.skipSpaces()
+	if p.pos == len(p.s) {
+		return nil, fmt.Errorf("expecting an operand at %d", p.pos)
+	}
+	sym := Symbol(p.s[p.pos])
+	switch sym {
+	case N, I, V, W, F, T, E:
+	case 'c':
+		// c and e are synonyms
+		sym = E
+	default:
+		return nil, fmt.Errorf("unknown operand `%c`", p.s[p.pos])
+	}
+	p.pos++
+
+	var mod int32
+	if p.token("%") {
+		var err error
+		if mod, err = p.value(); nil != err {
+			return nil, err
+		}
+		if 0 == mod {
+			return nil, fmt.Errorf("modulo by zero")
+		}
+	}
+
+	var eq bool
+	switch {
+	case p.token("!="):
+	case p.token("="):
+		eq = true
+	default:
+		return nil, fmt.Errorf("expecting = or != at %d", p.pos)
+	}
+
+	var items []*ruleNode
+	for {
+		from, err := p.value()
+		if nil != err {
+			return nil, err
+		}
+		if !p.token("..") {
+			if eq {
+				items = append(items, cmpNode(opEq, sym, mod, from))
+			} else {
+				items = append(items, cmpNode(opNe, sym, mod, from))
+			}
+		} else {
+			to, err := p.value()
+			if nil != err {
+				return nil, err
+			}
+			items = append(items, rangeNode(eq, sym, mod, from, to))
+		}
+		if !p.token(",") {
+			break
+		}
+	}
+	if eq {
+		return groupNode(opOr, items...), nil
+	}
+	return groupNode(opAnd, items...), nil
+}
+
+// rangeNode tells whether the operand is, or is not, in [from, to]. n is
+// only in a range when it is an integer.
+func rangeNode(eq bool, sym Symbol, mod, from, to int32) *ruleNode {
+	if eq {
+		in := []*ruleNode{cmpNode(opGe, sym, mod, from), cmpNode(opLe, sym, mod, to)}
+		if N == sym {
+			in = append([]*ruleNode{cmpNode(opNe, P, 0, 0)}, in...)
+		}
+		return groupNode(opAnd, in...)
+	}
+
+	out := []*ruleNode{cmpNode(opLt, sym, mod, from), cmpNode(opGt, sym, mod, to)}
+	if N == sym {
+		out = append([]*ruleNode{cmpNode(opEq, P, 0, 0)}, out...)
+	}
+	return groupNode(opOr, out...)
+}
+
+// compileCases compiles cases written as the generated conditions, such as
+// "n10 == 1 && n100 != 11", in their order.
+func compileCases(cases Cases) (*compiledRules, error) {
+	r := &compiledRules{cases: cases}
+	for _, c := range cases {
+		form, err := ParseCategory(c.Form)
+		if nil != err {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRule, err)
+		}
+		p := newRuleParser(c.Cond)
+		n, err := p.goCond()
+		if nil == err {
+			if p.skipSpaces(); p.pos != len(p.s) {
+				err = fmt.Errorf("unexpected `%s`", p.s[p.pos:])
+			}
+		}
+		if nil != err {
+			return nil, fmt.Errorf("%w: `%s`: %s", ErrInvalidRule, c.Cond, err)
+		}
+
+		start := len(r.code)
+		code := n.compile(nil)
+		if start+len(code) > math.MaxUint16 {
+			return nil, fmt.Errorf("%w: too many conditions", ErrInvalidRule)
+		}
+		r.code = append(r.code, code...)
+		r.rules = append(r.rules, rule{form, uint16(start), uint16(len(r.code))})
+	}
+	return r, nil
+}
+
+// goCond reads a generated condition:
+//
+//	cond     = and ("||" and)*
+//	and      = primary ("&&" primary)*
+//	primary  = "(" cond ")" | variable (("==" | "!=" | "<" | ">" | "<=" | ">=") value)?
+//	variable = ("n" | "i" | "v" | "w" | "f" | "t" | "e" | "p") value?
+func (p *ruleParser) goCond() (*ruleNode, error) {
+	var ors []*ruleNode
+	for {
+		var ands []*ruleNode
+		for {
+			n, err := p.goPrimary()
+			if nil != err {
+				return nil, err
+			}
+			ands = append(ands, n)
+			if !p.token("&&") {
+				break
+			}
+		}
+		ors = append(ors, groupNode(opAnd, ands...))
+		if !p.token("||") {
+			break
+		}
+	}
+	return groupNode(opOr, ors...), nil
+}
+
+var goCompareOps = []struct {
+	token string
+	op    opcode
+}{
+	{"==", opEq},
+	{"!=", opNe},
+	{"<=", opLe},
+	{">=", opGe},
+	{"<", opLt},
+	{">", opGt},
+}
+
+func (p *ruleParser) goPrimary() (*ruleNode, error) {
+	if p.token("(") {
+		n, err := p.goCond()
+		if nil != err {
+			return nil, err
+		}
+		if !p.token(")") {
+			return nil, fmt.Errorf("expecting ) at %d", p.pos)
+		}
+		return n, nil
+	}
+
+	p.skipSpaces()
+	if p.pos == len(p.s) {
+		return nil, fmt.Errorf("expecting a variable at %d", p.pos)
+	}
+	sym := Symbol(p.s[p.pos])
+	switch sym {
+	case N, I, V, W, F, T, E, P:
+	default:
+		return nil, fmt.Errorf("unknown variable `%c`", p.s[p.pos])
+	}
+	p.pos++
+
+	var mod int32
+	if p.pos < len(p.s) && isDigit(p.s[p.pos]) {
+		var err error
+		if mod, err = p.value(); nil != err {
+			return nil, err
+		}
+		if 0 == mod {
+			return nil, fmt.Errorf("modulo by zero")
+		}
+	}
+
+	for _, c := range goCompareOps {
+		if p.token(c.token) {
+			arg, err := p.value()
+			if nil != err {
+				return nil, err
+			}
+			return cmpNode(c.op, sym, mod, arg), nil
+		}
+	}
+	// a bare variable such as p is true when not zero
+	return cmpNode(opNe, sym, mod, 0), nil
+}
@@ -0,0 +1,150 @@
+package plural
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// cldrRules are CLDR rules of plurals.json and ordinals.json, samples left
+// out.
+var cldrRules = []struct {
+	culture           string
+	cardinal, ordinal map[string]string
+}{
+	{"ru", map[string]string{
+		"pluralRule-count-one":   "v = 0 and i % 10 = 1 and i % 100 != 11",
+		"pluralRule-count-few":   "v = 0 and i % 10 = 2..4 and i % 100 != 12..14",
+		"pluralRule-count-many":  "v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14",
+		"pluralRule-count-other": "",
+	}, nil},
+	{"lv", map[string]string{
+		"zero":  "n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19",
+		"one":   "n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1",
+		"other": " @integer 2~9, 22~29, 102, 1002, …",
+	}, map[string]string{
+		"other": "",
+	}},
+	{"br", map[string]string{
+		"one":   "n % 10 = 1 and n % 100 != 11,71,91",
+		"two":   "n % 10 = 2 and n % 100 != 12,72,92",
+		"few":   "n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99",
+		"many":  "n != 0 and n % 1000000 = 0",
+		"other": "",
+	}, nil},
+	{"fil", map[string]string{
+		"one": "v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9 @integer 0~3",
+	}, map[string]string{
+		"one": "n = 1",
+	}},
+	{"en", map[string]string{
+		"one": "i = 1 and v = 0",
+	}, map[string]string{
+		"one": "n % 10 = 1 and n % 100 != 11",
+		"two": "n % 10 = 2 and n % 100 != 12",
+		"few": "n % 10 = 3 and n % 100 != 13",
+	}},
+	{"mt", map[string]string{
+		"one":  "n = 1",
+		"few":  "n = 0 or n % 100 = 2..10",
+		"many": "n % 100 = 11..19",
+	}, nil},
+}
+
+func testValues() []string {
+	var values []string
+	for i := 0; i <= 1200; i++ {
+		values = append(values, strconv.Itoa(i))
+	}
+	for i := 0; i <= 120; i++ {
+		for _, fraction := range []string{".0", ".00", ".1", ".10", ".5", ".01", ".11", ".25"} {
+			values = append(values, strconv.Itoa(i)+fraction)
+		}
+	}
+	return append(values, "1000000", "2000000", "1000000.5")
+}
+
+func TestParseRules(t *testing.T) {
+	values := testValues()
+	for _, r := range cldrRules {
+		fn, err := GetOperandsFunc(language.MustParse(r.culture))
+		if nil != err {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		cardinal, err := ParseCardinal(r.cardinal)
+		if nil != err {
+			t.Fatalf("`%s` unexpected error: %s", r.culture, err.Error())
+		}
+		ordinal := OrdinalFunc(func(Operands) Category { return Other })
+		if nil != r.ordinal {
+			if ordinal, err = ParseOrdinal(r.ordinal); nil != err {
+				t.Fatalf("`%s` unexpected error: %s", r.culture, err.Error())
+			}
+		}
+
+		for _, value := range values {
+			o, _ := ParseOperands(value)
+			if expected, result := fn(o, false), cardinal(o); result != expected {
+				t.Errorf("`%s` cardinal %s expecting <%s> but got <%s>", r.culture, value, expected, result)
+			}
+			if expected, result := fn(o, true), ordinal(o); result != expected {
+				t.Errorf("`%s` ordinal %s expecting <%s> but got <%s>", r.culture, value, expected, result)
+			}
+		}
+	}
+}
+
+func TestParseRulesExponent(t *testing.T) {
+	// fr since CLDR 38, many being written with e or c
+	for _, operand := range []string{"e", "c"} {
+		cardinal, err := ParseCardinal(map[string]string{
+			"one":  "i = 0,1",
+			"many": operand + " = 0 and i != 0 and i % 1000000 = 0 and v = 0 or " + operand + " != 0..5",
+		})
+		if nil != err {
+			t.Fatalf("`%s` unexpected error: %s", operand, err.Error())
+		}
+		for value, expected := range map[string]Category{
+			"0":         One,
+			"1.5":       One,
+			"2":         Other,
+			"2000":      Other,
+			"1000000":   Many,
+			"3000000":   Many,
+			"1000000.0": Other,
+			"1000001":   Other,
+			"1.2c3":     Other,
+			"1c6":       Many,
+			"1.2c6":     Many,
+		} {
+			o, err := ParseOperands(value)
+			if nil != err {
+				t.Fatalf("`%s` unexpected error: %s", value, err.Error())
+			}
+			if result := cardinal(o); result != expected {
+				t.Errorf("`%s` %s expecting <%s> but got <%s>", operand, value, expected, result)
+			}
+		}
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	for _, rules := range []map[string]string{
+		{"one": "x = 0"},
+		{"one": "i = 1 and"},
+		{"one": "i == 1"},
+		{"one": "i % 0 = 1"},
+		{"one": "i = 1..", "other": ""},
+		{"one": "i = 99999999999"},
+		{"one": ""},
+		{"other": "i = 1"},
+		{"single": "i = 1"},
+		{"one": "i = 1 v = 0"},
+	} {
+		if _, err := ParseCardinal(rules); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("%v expecting ErrInvalidRule but got %v", rules, err)
+		}
+	}
+}
@@ -14,5 +14,6 @@ func (s Symbol) Name() string { return string(s) }
 // 	w  number of visible fraction digits in n, without trailing zeros.
 // 	f  visible fractional digits in n, with trailing zeros (f = t * 10^(v-w))
 // 	t  visible fractional digits in n, without trailing zeros.
+// 	e  exponent of the compact decimal notation, also named c.
 //  p := w == 0
-const U, F, I, N, V, T, W, P Symbol = 0, 'f', 'i', 'n', 'v', 't', 'w', 'p'
+const U, F, I, N, V, T, W, P, E Symbol = 0, 'f', 'i', 'n', 'v', 't', 'w', 'p', 'e'
@@ -6,7 +6,7 @@ import "strconv"
 
 const (
 	_Symbol_name_0 = "U"
-	_Symbol_name_1 = "F"
+	_Symbol_name_1 = "EF"
 	_Symbol_name_2 = "I"
 	_Symbol_name_3 = "N"
 	_Symbol_name_4 = "P"
@@ -15,6 +15,7 @@ const (
 )
 
 var (
+	_Symbol_index_1 = [...]uint8{0, 1, 2}
 	_Symbol_index_6 = [...]uint8{0, 1, 2}
 )
 
@@ -22,8 +23,9 @@ func (i Symbol) String() string {
 	switch {
 	case i == 0:
 		return _Symbol_name_0
-	case i == 102:
-		return _Symbol_name_1
+	case 101 <= i && i <= 102:
+		i -= 101
+		return _Symbol_name_1[_Symbol_index_1[i]:_Symbol_index_1[i+1]]
 	case i == 105:
 		return _Symbol_name_2
 	case i == 110:
@@ -0,0 +1,1664 @@
+// Generated by https://github.com/gotnospirit/makeplural
+// at 2026-10-18T19:01:27Z
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/ordinals.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/plurals.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/parentLocales.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/likelySubtags.json
+//
+//
+// URL: https://github.com/unicode-cldr/cldr-core/raw/master/supplemental/aliases.json
+//
+
+package plural
+
+var ruleCode = [...]instr{
+	// n == 1
+	{opEq, N, 0, 1},
+	// p && n >= 0 && n <= 1
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 0},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 1},
+	// i == 0 || n == 1
+	{opEq, I, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 1},
+	// n == 0
+	{opEq, N, 0, 0},
+	// n == 2
+	{opEq, N, 0, 2},
+	// p && n100 >= 3 && n100 <= 10
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 100, 3},
+	{opAnd, U, 0, 5},
+	{opLe, N, 100, 10},
+	// p && n100 >= 11 && n100 <= 99
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 100, 11},
+	{opAnd, U, 0, 5},
+	{opLe, N, 100, 99},
+	// n == 1 || n == 5 || n == 7 || n == 8 || n == 9 || n == 10
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 5},
+	{opOr, U, 0, 5},
+	{opEq, N, 0, 7},
+	{opOr, U, 0, 7},
+	{opEq, N, 0, 8},
+	{opOr, U, 0, 9},
+	{opEq, N, 0, 9},
+	{opOr, U, 0, 11},
+	{opEq, N, 0, 10},
+	// n == 2 || n == 3
+	{opEq, N, 0, 2},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 3},
+	// n == 4
+	{opEq, N, 0, 4},
+	// n == 6
+	{opEq, N, 0, 6},
+	// i == 1 && v == 0
+	{opEq, I, 0, 1},
+	{opAnd, U, 0, 3},
+	{opEq, V, 0, 0},
+	// i10 == 1 || i10 == 2 || i10 == 5 || i10 == 7 || i10 == 8 || i100 == 20 || i100 == 50 || i100 == 70 || i100 == 80
+	{opEq, I, 10, 1},
+	{opOr, U, 0, 3},
+	{opEq, I, 10, 2},
+	{opOr, U, 0, 5},
+	{opEq, I, 10, 5},
+	{opOr, U, 0, 7},
+	{opEq, I, 10, 7},
+	{opOr, U, 0, 9},
+	{opEq, I, 10, 8},
+	{opOr, U, 0, 11},
+	{opEq, I, 100, 20},
+	{opOr, U, 0, 13},
+	{opEq, I, 100, 50},
+	{opOr, U, 0, 15},
+	{opEq, I, 100, 70},
+	{opOr, U, 0, 17},
+	{opEq, I, 100, 80},
+	// i10 == 3 || i10 == 4 || i1000 == 100 || i1000 == 200 || i1000 == 300 || i1000 == 400 || i1000 == 500 || i1000 == 600 || i1000 == 700 || i1000 == 800 || i1000 == 900
+	{opEq, I, 10, 3},
+	{opOr, U, 0, 3},
+	{opEq, I, 10, 4},
+	{opOr, U, 0, 5},
+	{opEq, I, 1000, 100},
+	{opOr, U, 0, 7},
+	{opEq, I, 1000, 200},
+	{opOr, U, 0, 9},
+	{opEq, I, 1000, 300},
+	{opOr, U, 0, 11},
+	{opEq, I, 1000, 400},
+	{opOr, U, 0, 13},
+	{opEq, I, 1000, 500},
+	{opOr, U, 0, 15},
+	{opEq, I, 1000, 600},
+	{opOr, U, 0, 17},
+	{opEq, I, 1000, 700},
+	{opOr, U, 0, 19},
+	{opEq, I, 1000, 800},
+	{opOr, U, 0, 21},
+	{opEq, I, 1000, 900},
+	// i == 0 || i10 == 6 || i100 == 40 || i100 == 60 || i100 == 90
+	{opEq, I, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, I, 10, 6},
+	{opOr, U, 0, 5},
+	{opEq, I, 100, 40},
+	{opOr, U, 0, 7},
+	{opEq, I, 100, 60},
+	{opOr, U, 0, 9},
+	{opEq, I, 100, 90},
+	// n10 == 1 && n100 != 11
+	{opEq, N, 10, 1},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 11},
+	// p && n10 >= 2 && n10 <= 4 && (n100 < 12 || n100 > 14)
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 10, 2},
+	{opAnd, U, 0, 5},
+	{opLe, N, 10, 4},
+	{opAnd, U, 0, 9},
+	{opLt, N, 100, 12},
+	{opOr, U, 0, 9},
+	{opGt, N, 100, 14},
+	// n10 == 0 || p && n10 >= 5 && n10 <= 9 || p && n100 >= 11 && n100 <= 14
+	{opEq, N, 10, 0},
+	{opOr, U, 0, 7},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 5},
+	{opGe, N, 10, 5},
+	{opAnd, U, 0, 7},
+	{opLe, N, 10, 9},
+	{opOr, U, 0, 13},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 11},
+	{opGe, N, 100, 11},
+	{opAnd, U, 0, 13},
+	{opLe, N, 100, 14},
+	// (n10 == 2 || n10 == 3) && n100 != 12 && n100 != 13
+	{opEq, N, 10, 2},
+	{opOr, U, 0, 3},
+	{opEq, N, 10, 3},
+	{opAnd, U, 0, 5},
+	{opNe, N, 100, 12},
+	{opAnd, U, 0, 7},
+	{opNe, N, 100, 13},
+	// n10 == 1 && n100 != 11 && n100 != 71 && n100 != 91
+	{opEq, N, 10, 1},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 11},
+	{opAnd, U, 0, 5},
+	{opNe, N, 100, 71},
+	{opAnd, U, 0, 7},
+	{opNe, N, 100, 91},
+	// n10 == 2 && n100 != 12 && n100 != 72 && n100 != 92
+	{opEq, N, 10, 2},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 12},
+	{opAnd, U, 0, 5},
+	{opNe, N, 100, 72},
+	{opAnd, U, 0, 7},
+	{opNe, N, 100, 92},
+	// p && (p && n10 >= 3 && n10 <= 4 || n10 == 9) && (n100 < 10 || n100 > 19) && (n100 < 70 || n100 > 79) && (n100 < 90 || n100 > 99)
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 9},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 5},
+	{opGe, N, 10, 3},
+	{opAnd, U, 0, 7},
+	{opLe, N, 10, 4},
+	{opOr, U, 0, 9},
+	{opEq, N, 10, 9},
+	{opAnd, U, 0, 13},
+	{opLt, N, 100, 10},
+	{opOr, U, 0, 13},
+	{opGt, N, 100, 19},
+	{opAnd, U, 0, 17},
+	{opLt, N, 100, 70},
+	{opOr, U, 0, 17},
+	{opGt, N, 100, 79},
+	{opAnd, U, 0, 21},
+	{opLt, N, 100, 90},
+	{opOr, U, 0, 21},
+	{opGt, N, 100, 99},
+	// n != 0 && n1000000 == 0
+	{opNe, N, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, N, 1000000, 0},
+	// v == 0 && i10 == 1 && i100 != 11 || f10 == 1 && f100 != 11
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 10, 1},
+	{opAnd, U, 0, 5},
+	{opNe, I, 100, 11},
+	{opOr, U, 0, 9},
+	{opEq, F, 10, 1},
+	{opAnd, U, 0, 9},
+	{opNe, F, 100, 11},
+	// v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14) || f10 >= 2 && f10 <= 4 && (f100 < 12 || f100 > 14)
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, I, 10, 2},
+	{opAnd, U, 0, 5},
+	{opLe, I, 10, 4},
+	{opAnd, U, 0, 9},
+	{opLt, I, 100, 12},
+	{opOr, U, 0, 9},
+	{opGt, I, 100, 14},
+	{opOr, U, 0, 17},
+	{opGe, F, 10, 2},
+	{opAnd, U, 0, 13},
+	{opLe, F, 10, 4},
+	{opAnd, U, 0, 17},
+	{opLt, F, 100, 12},
+	{opOr, U, 0, 17},
+	{opGt, F, 100, 14},
+	// n == 1 || n == 3
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 3},
+	// v == 0 && (i == 1 || i == 2 || i == 3) || v == 0 && i10 != 4 && i10 != 6 && i10 != 9 || v != 0 && f10 != 4 && f10 != 6 && f10 != 9
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 7},
+	{opEq, I, 0, 1},
+	{opOr, U, 0, 5},
+	{opEq, I, 0, 2},
+	{opOr, U, 0, 7},
+	{opEq, I, 0, 3},
+	{opOr, U, 0, 15},
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 11},
+	{opNe, I, 10, 4},
+	{opAnd, U, 0, 13},
+	{opNe, I, 10, 6},
+	{opAnd, U, 0, 15},
+	{opNe, I, 10, 9},
+	{opOr, U, 0, 23},
+	{opNe, V, 0, 0},
+	{opAnd, U, 0, 19},
+	{opNe, F, 10, 4},
+	{opAnd, U, 0, 21},
+	{opNe, F, 10, 6},
+	{opAnd, U, 0, 23},
+	{opNe, F, 10, 9},
+	// i >= 2 && i <= 4 && v == 0
+	{opGe, I, 0, 2},
+	{opAnd, U, 0, 3},
+	{opLe, I, 0, 4},
+	{opAnd, U, 0, 5},
+	{opEq, V, 0, 0},
+	// v != 0
+	{opNe, V, 0, 0},
+	// n == 3
+	{opEq, N, 0, 3},
+	// n == 0 || n == 7 || n == 8 || n == 9
+	{opEq, N, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 7},
+	{opOr, U, 0, 5},
+	{opEq, N, 0, 8},
+	{opOr, U, 0, 7},
+	{opEq, N, 0, 9},
+	// n == 3 || n == 4
+	{opEq, N, 0, 3},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 4},
+	// n == 5 || n == 6
+	{opEq, N, 0, 5},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 6},
+	// n == 1 || t != 0 && (i == 0 || i == 1)
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 7},
+	{opNe, T, 0, 0},
+	{opAnd, U, 0, 7},
+	{opEq, I, 0, 0},
+	{opOr, U, 0, 7},
+	{opEq, I, 0, 1},
+	// v == 0 && i100 == 1 || f100 == 1
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 100, 1},
+	{opOr, U, 0, 5},
+	{opEq, F, 100, 1},
+	// v == 0 && i100 == 2 || f100 == 2
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 100, 2},
+	{opOr, U, 0, 5},
+	{opEq, F, 100, 2},
+	// v == 0 && i100 >= 3 && i100 <= 4 || f100 >= 3 && f100 <= 4
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, I, 100, 3},
+	{opAnd, U, 0, 5},
+	{opLe, I, 100, 4},
+	{opOr, U, 0, 9},
+	{opGe, F, 100, 3},
+	{opAnd, U, 0, 9},
+	{opLe, F, 100, 4},
+	// n10 == 2 && n100 != 12
+	{opEq, N, 10, 2},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 12},
+	// n10 == 3 && n100 != 13
+	{opEq, N, 10, 3},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 13},
+	// i == 0 || i == 1
+	{opEq, I, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, I, 0, 1},
+	// p && n >= 3 && n <= 6
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 3},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 6},
+	// p && n >= 7 && n <= 10
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 7},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 10},
+	// n == 1 || n == 11
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 11},
+	// n == 2 || n == 12
+	{opEq, N, 0, 2},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 12},
+	// p && n >= 3 && n <= 10 || p && n >= 13 && n <= 19
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 3},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 10},
+	{opOr, U, 0, 11},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 9},
+	{opGe, N, 0, 13},
+	{opAnd, U, 0, 11},
+	{opLe, N, 0, 19},
+	// n == 3 || n == 13
+	{opEq, N, 0, 3},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 13},
+	// v == 0 && i10 == 1
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 10, 1},
+	// v == 0 && i10 == 2
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 10, 2},
+	// v == 0 && (i100 == 0 || i100 == 20 || i100 == 40 || i100 == 60 || i100 == 80)
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 11},
+	{opEq, I, 100, 0},
+	{opOr, U, 0, 5},
+	{opEq, I, 100, 20},
+	{opOr, U, 0, 7},
+	{opEq, I, 100, 40},
+	{opOr, U, 0, 9},
+	{opEq, I, 100, 60},
+	{opOr, U, 0, 11},
+	{opEq, I, 100, 80},
+	// i == 2 && v == 0
+	{opEq, I, 0, 2},
+	{opAnd, U, 0, 3},
+	{opEq, V, 0, 0},
+	// p && v == 0 && (n < 0 || n > 10) && n10 == 0
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 7},
+	{opLt, N, 0, 0},
+	{opOr, U, 0, 7},
+	{opGt, N, 0, 10},
+	{opAnd, U, 0, 9},
+	{opEq, N, 10, 0},
+	// n == 1 || n == 5
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 5},
+	// t == 0 && i10 == 1 && i100 != 11 || t != 0
+	{opEq, T, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 10, 1},
+	{opAnd, U, 0, 5},
+	{opNe, I, 100, 11},
+	{opOr, U, 0, 7},
+	{opNe, T, 0, 0},
+	// n == 11 || n == 8 || n == 80 || n == 800
+	{opEq, N, 0, 11},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 8},
+	{opOr, U, 0, 5},
+	{opEq, N, 0, 80},
+	{opOr, U, 0, 7},
+	{opEq, N, 0, 800},
+	// i == 1
+	{opEq, I, 0, 1},
+	// i == 0 || i100 >= 2 && i100 <= 20 || i100 == 40 || i100 == 60 || i100 == 80
+	{opEq, I, 0, 0},
+	{opOr, U, 0, 5},
+	{opGe, I, 100, 2},
+	{opAnd, U, 0, 5},
+	{opLe, I, 100, 20},
+	{opOr, U, 0, 7},
+	{opEq, I, 100, 40},
+	{opOr, U, 0, 9},
+	{opEq, I, 100, 60},
+	{opOr, U, 0, 11},
+	{opEq, I, 100, 80},
+	// n10 == 6 || n10 == 9 || n10 == 0 && n != 0
+	{opEq, N, 10, 6},
+	{opOr, U, 0, 3},
+	{opEq, N, 10, 9},
+	{opOr, U, 0, 7},
+	{opEq, N, 10, 0},
+	{opAnd, U, 0, 7},
+	{opNe, N, 0, 0},
+	// n100 == 2 || n100 == 22 || n100 == 42 || n100 == 62 || n100 == 82
+	{opEq, N, 100, 2},
+	{opOr, U, 0, 3},
+	{opEq, N, 100, 22},
+	{opOr, U, 0, 5},
+	{opEq, N, 100, 42},
+	{opOr, U, 0, 7},
+	{opEq, N, 100, 62},
+	{opOr, U, 0, 9},
+	{opEq, N, 100, 82},
+	// n100 == 3 || n100 == 23 || n100 == 43 || n100 == 63 || n100 == 83
+	{opEq, N, 100, 3},
+	{opOr, U, 0, 3},
+	{opEq, N, 100, 23},
+	{opOr, U, 0, 5},
+	{opEq, N, 100, 43},
+	{opOr, U, 0, 7},
+	{opEq, N, 100, 63},
+	{opOr, U, 0, 9},
+	{opEq, N, 100, 83},
+	// n != 1 && (n100 == 1 || n100 == 21 || n100 == 41 || n100 == 61 || n100 == 81)
+	{opNe, N, 0, 1},
+	{opAnd, U, 0, 11},
+	{opEq, N, 100, 1},
+	{opOr, U, 0, 5},
+	{opEq, N, 100, 21},
+	{opOr, U, 0, 7},
+	{opEq, N, 100, 41},
+	{opOr, U, 0, 9},
+	{opEq, N, 100, 61},
+	{opOr, U, 0, 11},
+	{opEq, N, 100, 81},
+	// p && n >= 1 && n <= 4 || p && n100 >= 1 && n100 <= 4 || p && n100 >= 21 && n100 <= 24 || p && n100 >= 41 && n100 <= 44 || p && n100 >= 61 && n100 <= 64 || p && n100 >= 81 && n100 <= 84
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 1},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 4},
+	{opOr, U, 0, 11},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 9},
+	{opGe, N, 100, 1},
+	{opAnd, U, 0, 11},
+	{opLe, N, 100, 4},
+	{opOr, U, 0, 17},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 15},
+	{opGe, N, 100, 21},
+	{opAnd, U, 0, 17},
+	{opLe, N, 100, 24},
+	{opOr, U, 0, 23},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 21},
+	{opGe, N, 100, 41},
+	{opAnd, U, 0, 23},
+	{opLe, N, 100, 44},
+	{opOr, U, 0, 29},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 27},
+	{opGe, N, 100, 61},
+	{opAnd, U, 0, 29},
+	{opLe, N, 100, 64},
+	{opOr, U, 0, 35},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 33},
+	{opGe, N, 100, 81},
+	{opAnd, U, 0, 35},
+	{opLe, N, 100, 84},
+	// n == 5 || n100 == 5
+	{opEq, N, 0, 5},
+	{opOr, U, 0, 3},
+	{opEq, N, 100, 5},
+	// (i == 0 || i == 1) && n != 0
+	{opEq, I, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, I, 0, 1},
+	{opAnd, U, 0, 5},
+	{opNe, N, 0, 0},
+	// p && n10 == 1 && (n100 < 11 || n100 > 19)
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, N, 10, 1},
+	{opAnd, U, 0, 7},
+	{opLt, N, 100, 11},
+	{opOr, U, 0, 7},
+	{opGt, N, 100, 19},
+	// p && n10 >= 2 && n10 <= 9 && (n100 < 11 || n100 > 19)
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 10, 2},
+	{opAnd, U, 0, 5},
+	{opLe, N, 10, 9},
+	{opAnd, U, 0, 9},
+	{opLt, N, 100, 11},
+	{opOr, U, 0, 9},
+	{opGt, N, 100, 19},
+	// f != 0
+	{opNe, F, 0, 0},
+	// n10 == 0 || p && n100 >= 11 && n100 <= 19 || v == 2 && f100 >= 11 && f100 <= 19
+	{opEq, N, 10, 0},
+	{opOr, U, 0, 7},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 5},
+	{opGe, N, 100, 11},
+	{opAnd, U, 0, 7},
+	{opLe, N, 100, 19},
+	{opOr, U, 0, 13},
+	{opEq, V, 0, 2},
+	{opAnd, U, 0, 11},
+	{opGe, F, 100, 11},
+	{opAnd, U, 0, 13},
+	{opLe, F, 100, 19},
+	// n10 == 1 && n100 != 11 || v == 2 && f10 == 1 && f100 != 11 || v != 2 && f10 == 1
+	{opEq, N, 10, 1},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 11},
+	{opOr, U, 0, 9},
+	{opEq, V, 0, 2},
+	{opAnd, U, 0, 7},
+	{opEq, F, 10, 1},
+	{opAnd, U, 0, 9},
+	{opNe, F, 100, 11},
+	{opOr, U, 0, 13},
+	{opNe, V, 0, 2},
+	{opAnd, U, 0, 13},
+	{opEq, F, 10, 1},
+	// i10 == 1 && i100 != 11
+	{opEq, I, 10, 1},
+	{opAnd, U, 0, 3},
+	{opNe, I, 100, 11},
+	// i10 == 2 && i100 != 12
+	{opEq, I, 10, 2},
+	{opAnd, U, 0, 3},
+	{opNe, I, 100, 12},
+	// (i10 == 7 || i10 == 8) && i100 != 17 && i100 != 18
+	{opEq, I, 10, 7},
+	{opOr, U, 0, 3},
+	{opEq, I, 10, 8},
+	{opAnd, U, 0, 5},
+	{opNe, I, 100, 17},
+	{opAnd, U, 0, 7},
+	{opNe, I, 100, 18},
+	// v != 0 || n == 0 || p && n100 >= 2 && n100 <= 19
+	{opNe, V, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 0},
+	{opOr, U, 0, 9},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 7},
+	{opGe, N, 100, 2},
+	{opAnd, U, 0, 9},
+	{opLe, N, 100, 19},
+	// n == 0 || p && n100 >= 2 && n100 <= 10
+	{opEq, N, 0, 0},
+	{opOr, U, 0, 7},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 5},
+	{opGe, N, 100, 2},
+	{opAnd, U, 0, 7},
+	{opLe, N, 100, 10},
+	// p && n100 >= 11 && n100 <= 19
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 100, 11},
+	{opAnd, U, 0, 5},
+	{opLe, N, 100, 19},
+	// p && n >= 1 && n <= 4
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 1},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 4},
+	// n == 1 || n == 5 || p && n >= 7 && n <= 9
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 5},
+	{opOr, U, 0, 9},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 7},
+	{opGe, N, 0, 7},
+	{opAnd, U, 0, 9},
+	{opLe, N, 0, 9},
+	// v == 0 && i10 >= 2 && i10 <= 4 && (i100 < 12 || i100 > 14)
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, I, 10, 2},
+	{opAnd, U, 0, 5},
+	{opLe, I, 10, 4},
+	{opAnd, U, 0, 9},
+	{opLt, I, 100, 12},
+	{opOr, U, 0, 9},
+	{opGt, I, 100, 14},
+	// v == 0 && i != 1 && i10 >= 0 && i10 <= 1 || v == 0 && i10 >= 5 && i10 <= 9 || v == 0 && i100 >= 12 && i100 <= 14
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opNe, I, 0, 1},
+	{opAnd, U, 0, 5},
+	{opGe, I, 10, 0},
+	{opAnd, U, 0, 7},
+	{opLe, I, 10, 1},
+	{opOr, U, 0, 13},
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 11},
+	{opGe, I, 10, 5},
+	{opAnd, U, 0, 13},
+	{opLe, I, 10, 9},
+	{opOr, U, 0, 19},
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 17},
+	{opGe, I, 100, 12},
+	{opAnd, U, 0, 19},
+	{opLe, I, 100, 14},
+	// i >= 0 && i <= 1
+	{opGe, I, 0, 0},
+	{opAnd, U, 0, 3},
+	{opLe, I, 0, 1},
+	// v == 0 && i10 == 1 && i100 != 11
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 10, 1},
+	{opAnd, U, 0, 5},
+	{opNe, I, 100, 11},
+	// v == 0 && i10 == 0 || v == 0 && i10 >= 5 && i10 <= 9 || v == 0 && i100 >= 11 && i100 <= 14
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 10, 0},
+	{opOr, U, 0, 9},
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 7},
+	{opGe, I, 10, 5},
+	{opAnd, U, 0, 9},
+	{opLe, I, 10, 9},
+	{opOr, U, 0, 15},
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 13},
+	{opGe, I, 100, 11},
+	{opAnd, U, 0, 15},
+	{opLe, I, 100, 14},
+	// p && n >= 2 && n <= 10
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 2},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 10},
+	// n == 0 || n == 1 || i == 0 && f == 1
+	{opEq, N, 0, 0},
+	{opOr, U, 0, 3},
+	{opEq, N, 0, 1},
+	{opOr, U, 0, 7},
+	{opEq, I, 0, 0},
+	{opAnd, U, 0, 7},
+	{opEq, F, 0, 1},
+	// v == 0 && i100 == 1
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 100, 1},
+	// v == 0 && i100 == 2
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opEq, I, 100, 2},
+	// v == 0 && i100 >= 3 && i100 <= 4 || v != 0
+	{opEq, V, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, I, 100, 3},
+	{opAnd, U, 0, 5},
+	{opLe, I, 100, 4},
+	{opOr, U, 0, 7},
+	{opNe, V, 0, 0},
+	// n10 == 4 && n100 != 14
+	{opEq, N, 10, 4},
+	{opAnd, U, 0, 3},
+	{opNe, N, 100, 14},
+	// (n10 == 1 || n10 == 2) && n100 != 11 && n100 != 12
+	{opEq, N, 10, 1},
+	{opOr, U, 0, 3},
+	{opEq, N, 10, 2},
+	{opAnd, U, 0, 5},
+	{opNe, N, 100, 11},
+	{opAnd, U, 0, 7},
+	{opNe, N, 100, 12},
+	// n10 == 6 || n10 == 9 || n == 10
+	{opEq, N, 10, 6},
+	{opOr, U, 0, 3},
+	{opEq, N, 10, 9},
+	{opOr, U, 0, 5},
+	{opEq, N, 0, 10},
+	// p && n >= 0 && n <= 1 || p && n >= 11 && n <= 99
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 3},
+	{opGe, N, 0, 0},
+	{opAnd, U, 0, 5},
+	{opLe, N, 0, 1},
+	{opOr, U, 0, 11},
+	{opNe, P, 0, 0},
+	{opAnd, U, 0, 9},
+	{opGe, N, 0, 11},
+	{opAnd, U, 0, 11},
+	{opLe, N, 0, 99},
+}
+
+var ruleSets = [...]ruleSet{
+	// af, an, bg, ce, el, es, eu, gsw, ky, ml, mn, nb, ps, sd, ta, te, tr, uz
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// ak, bho, guw, ln, mg, nso, ti, wa
+	{
+		cardinal: []rule{
+			{One, 1, 6},
+		},
+	},
+	// am, fa, kn, zu
+	{
+		cardinal: []rule{
+			{One, 6, 9},
+		},
+	},
+	// ar
+	{
+		cardinal: []rule{
+			{Zero, 9, 10},
+			{One, 0, 1},
+			{Two, 10, 11},
+			{Few, 11, 16},
+			{Many, 16, 21},
+		},
+	},
+	// ars
+	{
+		cardinal: []rule{
+			{Zero, 9, 10},
+			{One, 0, 1},
+			{Two, 10, 11},
+			{Few, 11, 16},
+			{Many, 16, 21},
+		},
+	},
+	// as, bn
+	{
+		cardinal: []rule{
+			{One, 6, 9},
+		},
+		ordinal: []rule{
+			{One, 21, 32},
+			{Two, 32, 35},
+			{Few, 35, 36},
+			{Many, 36, 37},
+		},
+	},
+	// asa, bem, bez, brx, cgg, chr, ckb, dv, ee, eo, fo, fur, ha, haw, jgo, jmc, kaj, kcg, kkj, kl, ks, ksb, ku, lb, lg, mas, mgo, nah, nd, nn, nnh, no, nr, ny, nyn, om, os, pap, rm, rof, rwk, saq, sdh, seh, sn, so, ss, ssy, st, syr, teo, tig, tn, ts, ug, ve, vo, vun, wae, xh, xog
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// ast, io, ji, pt-PT, yi
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+		},
+	},
+	// az
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 40, 57},
+			{Few, 57, 78},
+			{Many, 78, 87},
+		},
+	},
+	// be
+	{
+		cardinal: []rule{
+			{One, 87, 90},
+			{Few, 90, 99},
+			{Many, 99, 112},
+		},
+		ordinal: []rule{
+			{Few, 112, 119},
+		},
+	},
+	// br
+	{
+		cardinal: []rule{
+			{One, 119, 126},
+			{Two, 126, 133},
+			{Few, 133, 154},
+			{Many, 154, 157},
+		},
+	},
+	// bs, hr, sh, sr, sr-Latn
+	{
+		cardinal: []rule{
+			{One, 157, 166},
+			{Few, 166, 183},
+		},
+	},
+	// ca
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+		},
+		ordinal: []rule{
+			{One, 183, 186},
+			{Two, 10, 11},
+			{Few, 35, 36},
+		},
+	},
+	// ceb
+	{
+		cardinal: []rule{
+			{One, 186, 209},
+		},
+	},
+	// cs, sk
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+			{Few, 209, 214},
+			{Many, 214, 215},
+		},
+	},
+	// cy
+	{
+		cardinal: []rule{
+			{Zero, 9, 10},
+			{One, 0, 1},
+			{Two, 10, 11},
+			{Few, 215, 216},
+			{Many, 36, 37},
+		},
+		ordinal: []rule{
+			{Zero, 216, 223},
+			{One, 0, 1},
+			{Two, 10, 11},
+			{Few, 223, 226},
+			{Many, 226, 229},
+		},
+	},
+	// da
+	{
+		cardinal: []rule{
+			{One, 229, 236},
+		},
+	},
+	// de, et, fi, fy, gl, ia, nl, sw, ur
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+		},
+	},
+	// dsb, hsb
+	{
+		cardinal: []rule{
+			{One, 236, 241},
+			{Two, 241, 246},
+			{Few, 246, 255},
+		},
+	},
+	// en
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+		},
+		ordinal: []rule{
+			{One, 87, 90},
+			{Two, 255, 258},
+			{Few, 258, 261},
+		},
+	},
+	// ff, kab
+	{
+		cardinal: []rule{
+			{One, 261, 264},
+		},
+	},
+	// fil, tl
+	{
+		cardinal: []rule{
+			{One, 186, 209},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// fr, hy
+	{
+		cardinal: []rule{
+			{One, 261, 264},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// ga
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+			{Two, 10, 11},
+			{Few, 264, 269},
+			{Many, 269, 274},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// gd
+	{
+		cardinal: []rule{
+			{One, 274, 277},
+			{Two, 277, 280},
+			{Few, 280, 291},
+		},
+		ordinal: []rule{
+			{One, 274, 277},
+			{Two, 277, 280},
+			{Few, 291, 294},
+		},
+	},
+	// gu, hi
+	{
+		cardinal: []rule{
+			{One, 6, 9},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+			{Two, 32, 35},
+			{Few, 35, 36},
+			{Many, 36, 37},
+		},
+	},
+	// gv
+	{
+		cardinal: []rule{
+			{One, 294, 297},
+			{Two, 297, 300},
+			{Few, 300, 311},
+			{Many, 214, 215},
+		},
+	},
+	// he, iw
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+			{Two, 311, 314},
+			{Many, 314, 323},
+		},
+	},
+	// hu
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 323, 326},
+		},
+	},
+	// is
+	{
+		cardinal: []rule{
+			{One, 326, 333},
+		},
+	},
+	// it, sc, scn
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+		},
+		ordinal: []rule{
+			{Many, 333, 340},
+		},
+	},
+	// iu, naq, se, sma, smi, smj, smn, sms
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+			{Two, 10, 11},
+		},
+	},
+	// ka
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 340, 341},
+			{Many, 341, 352},
+		},
+	},
+	// kk
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{Many, 352, 359},
+		},
+	},
+	// ksh
+	{
+		cardinal: []rule{
+			{Zero, 9, 10},
+			{One, 0, 1},
+		},
+	},
+	// kw
+	{
+		cardinal: []rule{
+			{Zero, 9, 10},
+			{One, 0, 1},
+			{Two, 359, 368},
+			{Few, 368, 377},
+			{Many, 377, 388},
+		},
+		ordinal: []rule{
+			{One, 388, 423},
+			{Many, 423, 426},
+		},
+	},
+	// lag
+	{
+		cardinal: []rule{
+			{Zero, 9, 10},
+			{One, 426, 431},
+		},
+	},
+	// lo, ms, vi
+	{
+		ordinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// lt
+	{
+		cardinal: []rule{
+			{One, 431, 438},
+			{Few, 438, 447},
+			{Many, 447, 448},
+		},
+	},
+	// lv, prg
+	{
+		cardinal: []rule{
+			{Zero, 448, 461},
+			{One, 461, 474},
+		},
+	},
+	// mk
+	{
+		cardinal: []rule{
+			{One, 157, 166},
+		},
+		ordinal: []rule{
+			{One, 474, 477},
+			{Two, 477, 480},
+			{Many, 480, 487},
+		},
+	},
+	// mo, ro, ro-MD
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+			{Few, 487, 496},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+		},
+	},
+	// mr
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+			{Two, 32, 35},
+			{Few, 35, 36},
+		},
+	},
+	// mt
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+			{Few, 496, 503},
+			{Many, 503, 508},
+		},
+	},
+	// ne
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 508, 513},
+		},
+	},
+	// or
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 513, 522},
+			{Two, 32, 35},
+			{Few, 35, 36},
+			{Many, 36, 37},
+		},
+	},
+	// pa
+	{
+		cardinal: []rule{
+			{One, 1, 6},
+		},
+	},
+	// pl
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+			{Few, 522, 531},
+			{Many, 531, 550},
+		},
+	},
+	// pt
+	{
+		cardinal: []rule{
+			{One, 550, 553},
+		},
+	},
+	// ru
+	{
+		cardinal: []rule{
+			{One, 553, 558},
+			{Few, 522, 531},
+			{Many, 558, 573},
+		},
+	},
+	// shi
+	{
+		cardinal: []rule{
+			{One, 6, 9},
+			{Few, 573, 578},
+		},
+	},
+	// si
+	{
+		cardinal: []rule{
+			{One, 578, 585},
+		},
+	},
+	// sl
+	{
+		cardinal: []rule{
+			{One, 585, 588},
+			{Two, 588, 591},
+			{Few, 591, 598},
+		},
+	},
+	// sq
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{One, 0, 1},
+			{Many, 598, 601},
+		},
+	},
+	// sv
+	{
+		cardinal: []rule{
+			{One, 37, 40},
+		},
+		ordinal: []rule{
+			{One, 601, 608},
+		},
+	},
+	// tk
+	{
+		cardinal: []rule{
+			{One, 0, 1},
+		},
+		ordinal: []rule{
+			{Few, 608, 613},
+		},
+	},
+	// tzm
+	{
+		cardinal: []rule{
+			{One, 613, 624},
+		},
+	},
+	// uk
+	{
+		cardinal: []rule{
+			{One, 553, 558},
+			{Few, 522, 531},
+			{Many, 558, 573},
+		},
+		ordinal: []rule{
+			{Few, 258, 261},
+		},
+	},
+	// bm, bo, dz, id, ig, ii, in, ja, jbo, jv, jw, kde, kea, km, ko, lkt, my, nqo, osa, root, sah, ses, sg, su, th, to, wo, yo, yue, zh
+	{},
+}
+
+var ruleSetTags = [...]string{
+	"af",
+	"ak",
+	"am",
+	"an",
+	"ar",
+	"ars",
+	"as",
+	"asa",
+	"ast",
+	"az",
+	"be",
+	"bem",
+	"bez",
+	"bg",
+	"bho",
+	"bm",
+	"bn",
+	"bo",
+	"br",
+	"brx",
+	"bs",
+	"ca",
+	"ce",
+	"ceb",
+	"cgg",
+	"chr",
+	"ckb",
+	"cs",
+	"cy",
+	"da",
+	"de",
+	"dsb",
+	"dv",
+	"dz",
+	"ee",
+	"el",
+	"en",
+	"eo",
+	"es",
+	"et",
+	"eu",
+	"fa",
+	"ff",
+	"fi",
+	"fil",
+	"fo",
+	"fr",
+	"fur",
+	"fy",
+	"ga",
+	"gd",
+	"gl",
+	"gsw",
+	"gu",
+	"guw",
+	"gv",
+	"ha",
+	"haw",
+	"he",
+	"hi",
+	"hr",
+	"hsb",
+	"hu",
+	"hy",
+	"ia",
+	"id",
+	"ig",
+	"ii",
+	"io",
+	"is",
+	"it",
+	"iu",
+	"ja",
+	"jbo",
+	"jgo",
+	"jmc",
+	"jv",
+	"ka",
+	"kab",
+	"kaj",
+	"kcg",
+	"kde",
+	"kea",
+	"kk",
+	"kkj",
+	"kl",
+	"km",
+	"kn",
+	"ko",
+	"ks",
+	"ksb",
+	"ksh",
+	"ku",
+	"kw",
+	"ky",
+	"lag",
+	"lb",
+	"lg",
+	"lkt",
+	"ln",
+	"lo",
+	"lt",
+	"lv",
+	"mas",
+	"mg",
+	"mgo",
+	"mk",
+	"ml",
+	"mn",
+	"mr",
+	"ms",
+	"mt",
+	"my",
+	"nah",
+	"naq",
+	"nb",
+	"nd",
+	"ne",
+	"nl",
+	"nn",
+	"nnh",
+	"no",
+	"nqo",
+	"nr",
+	"nso",
+	"ny",
+	"nyn",
+	"om",
+	"or",
+	"os",
+	"osa",
+	"pa",
+	"pap",
+	"pl",
+	"prg",
+	"ps",
+	"pt",
+	"pt-PT",
+	"rm",
+	"ro",
+	"ro-MD",
+	"rof",
+	"ru",
+	"rwk",
+	"sah",
+	"saq",
+	"sc",
+	"scn",
+	"sd",
+	"sdh",
+	"se",
+	"seh",
+	"ses",
+	"sg",
+	"shi",
+	"si",
+	"sk",
+	"sl",
+	"sma",
+	"smi",
+	"smj",
+	"smn",
+	"sms",
+	"sn",
+	"so",
+	"sq",
+	"sr",
+	"sr-Latn",
+	"ss",
+	"ssy",
+	"st",
+	"su",
+	"sv",
+	"sw",
+	"syr",
+	"ta",
+	"te",
+	"teo",
+	"th",
+	"ti",
+	"tig",
+	"tk",
+	"tn",
+	"to",
+	"tr",
+	"ts",
+	"tzm",
+	"ug",
+	"uk",
+	"und",
+	"ur",
+	"uz",
+	"ve",
+	"vi",
+	"vo",
+	"vun",
+	"wa",
+	"wae",
+	"wo",
+	"xh",
+	"xog",
+	"yi",
+	"yo",
+	"yue",
+	"zh",
+	"zu",
+}
+
+var ruleSetIndex = [...]uint16{
+	0,  // af
+	1,  // ak
+	2,  // am
+	0,  // an
+	3,  // ar
+	4,  // ars
+	5,  // as
+	6,  // asa
+	7,  // ast
+	8,  // az
+	9,  // be
+	6,  // bem
+	6,  // bez
+	0,  // bg
+	1,  // bho
+	58, // bm
+	5,  // bn
+	58, // bo
+	10, // br
+	6,  // brx
+	11, // bs
+	12, // ca
+	0,  // ce
+	13, // ceb
+	6,  // cgg
+	6,  // chr
+	6,  // ckb
+	14, // cs
+	15, // cy
+	16, // da
+	17, // de
+	18, // dsb
+	6,  // dv
+	58, // dz
+	6,  // ee
+	0,  // el
+	19, // en
+	6,  // eo
+	0,  // es
+	17, // et
+	0,  // eu
+	2,  // fa
+	20, // ff
+	17, // fi
+	21, // fil
+	6,  // fo
+	22, // fr
+	6,  // fur
+	17, // fy
+	23, // ga
+	24, // gd
+	17, // gl
+	0,  // gsw
+	25, // gu
+	1,  // guw
+	26, // gv
+	6,  // ha
+	6,  // haw
+	27, // he
+	25, // hi
+	11, // hr
+	18, // hsb
+	28, // hu
+	22, // hy
+	17, // ia
+	58, // id
+	58, // ig
+	58, // ii
+	7,  // io
+	29, // is
+	30, // it
+	31, // iu
+	58, // ja
+	58, // jbo
+	6,  // jgo
+	6,  // jmc
+	58, // jv
+	32, // ka
+	20, // kab
+	6,  // kaj
+	6,  // kcg
+	58, // kde
+	58, // kea
+	33, // kk
+	6,  // kkj
+	6,  // kl
+	58, // km
+	2,  // kn
+	58, // ko
+	6,  // ks
+	6,  // ksb
+	34, // ksh
+	6,  // ku
+	35, // kw
+	0,  // ky
+	36, // lag
+	6,  // lb
+	6,  // lg
+	58, // lkt
+	1,  // ln
+	37, // lo
+	38, // lt
+	39, // lv
+	6,  // mas
+	1,  // mg
+	6,  // mgo
+	40, // mk
+	0,  // ml
+	0,  // mn
+	42, // mr
+	37, // ms
+	43, // mt
+	58, // my
+	6,  // nah
+	31, // naq
+	0,  // nb
+	6,  // nd
+	44, // ne
+	17, // nl
+	6,  // nn
+	6,  // nnh
+	6,  // no
+	58, // nqo
+	6,  // nr
+	1,  // nso
+	6,  // ny
+	6,  // nyn
+	6,  // om
+	45, // or
+	6,  // os
+	58, // osa
+	46, // pa
+	6,  // pap
+	47, // pl
+	39, // prg
+	0,  // ps
+	48, // pt
+	7,  // pt-PT
+	6,  // rm
+	41, // ro
+	41, // ro-MD
+	6,  // rof
+	49, // ru
+	6,  // rwk
+	58, // sah
+	6,  // saq
+	30, // sc
+	30, // scn
+	0,  // sd
+	6,  // sdh
+	31, // se
+	6,  // seh
+	58, // ses
+	58, // sg
+	50, // shi
+	51, // si
+	14, // sk
+	52, // sl
+	31, // sma
+	31, // smi
+	31, // smj
+	31, // smn
+	31, // sms
+	6,  // sn
+	6,  // so
+	53, // sq
+	11, // sr
+	11, // sr-Latn
+	6,  // ss
+	6,  // ssy
+	6,  // st
+	58, // su
+	54, // sv
+	17, // sw
+	6,  // syr
+	0,  // ta
+	0,  // te
+	6,  // teo
+	58, // th
+	1,  // ti
+	6,  // tig
+	55, // tk
+	6,  // tn
+	58, // to
+	0,  // tr
+	6,  // ts
+	56, // tzm
+	6,  // ug
+	57, // uk
+	58, // und
+	17, // ur
+	0,  // uz
+	6,  // ve
+	37, // vi
+	6,  // vo
+	6,  // vun
+	1,  // wa
+	6,  // wae
+	58, // wo
+	6,  // xh
+	6,  // xog
+	7,  // yi
+	58, // yo
+	58, // yue
+	58, // zh
+	2,  // zu
+}
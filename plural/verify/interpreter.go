@@ -0,0 +1,329 @@
+// Package verify checks the generated plural functions against an
+// independent interpreter of the CLDR rules.
+//
+// The interpreter reads the rules as CLDR writes them in plurals.json and
+// ordinals.json, "n % 10 = 1 and n % 100 != 11 @integer 1, 21, …", and
+// computes the operands of a number exactly with math/big. It shares no code
+// with the generator, the closures of func.go nor the compact tables, so
+// that a rule the generator translated wrong shows up as a Mismatch.
+package verify
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// categories are the CLDR categories in the order their rules are tried,
+// "other" being the fallback.
+var categories = []string{"zero", "one", "two", "few", "many"}
+
+// Rules are the parsed CLDR rules of a locale. Without ordinal rules,
+// ordinals are only "other".
+type Rules struct {
+	cardinal []rule
+	ordinal  []rule
+	samples  []Sample
+}
+
+type rule struct {
+	form string
+	cond condition
+}
+
+// Compile parses the CLDR rules of a locale, keyed by category as in
+// plurals.json, "pluralRule-count-one", or by the bare category, "one".
+// ordinal is nil for the locales CLDR has no ordinal rules for. Empty
+// rules evaluate to "other", as the Others locales do.
+func Compile(cardinal, ordinal map[string]string) (*Rules, error) {
+	r := &Rules{}
+	var err error
+	if r.cardinal, err = r.compileRules(cardinal, false); nil != err {
+		return nil, err
+	}
+	if r.ordinal, err = r.compileRules(ordinal, true); nil != err {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Rules) compileRules(rules map[string]string, ordinal bool) ([]rule, error) {
+	var compiled []rule
+	for _, form := range append(categories, "other") {
+		s, ok := rules["pluralRule-count-"+form]
+		if !ok {
+			if s, ok = rules[form]; !ok {
+				continue
+			}
+		}
+
+		cond := s
+		if idx := strings.IndexByte(s, '@'); idx >= 0 {
+			cond = s[:idx]
+			r.addSamples(s[idx:], form, ordinal)
+		}
+		if "other" == form {
+			if "" != strings.TrimSpace(cond) {
+				return nil, fmt.Errorf("InvalidRule: `%s`: other has a condition", s)
+			}
+			continue
+		}
+
+		c, err := parseCondition(cond)
+		if nil != err {
+			return nil, fmt.Errorf("InvalidRule: `%s`: %s", s, err)
+		}
+		compiled = append(compiled, rule{form, c})
+	}
+	return compiled, nil
+}
+
+// addSamples keeps the values of the "@integer" and "@decimal" samples of a
+// rule.
+func (r *Rules) addSamples(samples, form string, ordinal bool) {
+	for _, part := range strings.Split(samples, "@") {
+		for _, kind := range []string{"integer", "decimal"} {
+			if strings.HasPrefix(part, kind) {
+				for _, value := range ExpandSamples(part[len(kind):]) {
+					r.samples = append(r.samples, Sample{value, ordinal, form})
+				}
+			}
+		}
+	}
+}
+
+// Samples returns the CLDR samples of the rules, cardinals first.
+func (r *Rules) Samples() []Sample {
+	return r.samples
+}
+
+// Eval returns the category of value, a non-negative decimal string such as
+// "12" or "1.50", which may have a compact exponent, "1.2c3". Ordinals with
+// a non-zero fraction are "other".
+func (r *Rules) Eval(value string, ordinal bool) (string, error) {
+	o, err := newOperands(value)
+	if nil != err {
+		return "", err
+	}
+
+	rules := r.cardinal
+	if ordinal {
+		if 0 != o.w {
+			return "other", nil
+		}
+		rules = r.ordinal
+	}
+	for _, rule := range rules {
+		if rule.cond.eval(o) {
+			return rule.form, nil
+		}
+	}
+	return "other", nil
+}
+
+// condition is a CLDR condition, relations joined by "and" in each of the
+// alternatives joined by "or".
+type condition [][]relation
+
+// relation is "operand % mod = ranges", or "!=" when negated.
+type relation struct {
+	operand byte
+	mod     *big.Int
+	negated bool
+	ranges  [][2]*big.Int
+}
+
+func (c condition) eval(o *operands) bool {
+	for _, and := range c {
+		match := true
+		for _, r := range and {
+			if !r.eval(o) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// eval tells whether the operand is in the ranges, which only hold integers:
+// "n = 1..3" is false for 1.5.
+func (r relation) eval(o *operands) bool {
+	x := o.value(r.operand, r.mod)
+	in := false
+	if x.IsInt() {
+		for _, rng := range r.ranges {
+			if x.Num().Cmp(rng[0]) >= 0 && x.Num().Cmp(rng[1]) <= 0 {
+				in = true
+				break
+			}
+		}
+	}
+	return in != r.negated
+}
+
+// parseCondition parses the condition of a CLDR rule, samples excluded.
+func parseCondition(s string) (condition, error) {
+	if "" == strings.TrimSpace(s) {
+		return nil, fmt.Errorf("empty condition")
+	}
+
+	var c condition
+	for _, or := range strings.Split(s, " or ") {
+		var and []relation
+		for _, rel := range strings.Split(or, " and ") {
+			r, err := parseRelation(rel)
+			if nil != err {
+				return nil, err
+			}
+			and = append(and, r)
+		}
+		c = append(c, and)
+	}
+	return c, nil
+}
+
+// parseRelation parses "n % 100 != 11..19,70" and the like.
+func parseRelation(s string) (relation, error) {
+	var r relation
+	idx := strings.Index(s, "=")
+	if idx <= 0 {
+		return r, fmt.Errorf("invalid relation `%s`", strings.TrimSpace(s))
+	}
+	expr, list := s[:idx], s[idx+1:]
+	if strings.HasSuffix(expr, "!") {
+		r.negated, expr = true, expr[:len(expr)-1]
+	}
+
+	fields := strings.Fields(strings.Replace(expr, "%", " % ", 1))
+	switch {
+	case 1 == len(fields):
+	case 3 == len(fields) && "%" == fields[1]:
+		mod, ok := new(big.Int).SetString(fields[2], 10)
+		if !ok || mod.Sign() <= 0 {
+			return r, fmt.Errorf("invalid modulus `%s`", fields[2])
+		}
+		r.mod = mod
+	default:
+		return r, fmt.Errorf("invalid expression `%s`", strings.TrimSpace(expr))
+	}
+	if 1 != len(fields[0]) || !strings.Contains("nivwftce", fields[0]) {
+		return r, fmt.Errorf("UnknownOperand: `%s`", fields[0])
+	}
+	r.operand = fields[0][0]
+
+	for _, item := range strings.Split(list, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(item), "..", 2)
+		from, ok := new(big.Int).SetString(strings.TrimSpace(bounds[0]), 10)
+		if !ok {
+			return r, fmt.Errorf("invalid value `%s`", strings.TrimSpace(item))
+		}
+		to := from
+		if 2 == len(bounds) {
+			if to, ok = new(big.Int).SetString(strings.TrimSpace(bounds[1]), 10); !ok || to.Cmp(from) < 0 {
+				return r, fmt.Errorf("invalid range `%s`", strings.TrimSpace(item))
+			}
+		}
+		r.ranges = append(r.ranges, [2]*big.Int{from, to})
+	}
+	return r, nil
+}
+
+// operands are the TR35 operands of a number, computed exactly.
+type operands struct {
+	n, fraction *big.Rat
+	i, f, t     *big.Int
+	v, w, e     int
+}
+
+func newOperands(value string) (*operands, error) {
+	plain, e := value, 0
+	if idx := strings.IndexAny(value, "ce"); idx >= 0 {
+		exp, err := strconv.Atoi(value[idx+1:])
+		if nil != err || exp < 0 || exp > MaxRange {
+			return nil, fmt.Errorf("InvalidNumber: `%s`", value)
+		}
+		plain, e = value[:idx], exp
+	}
+
+	intPart, fracPart := plain, ""
+	if idx := strings.IndexByte(plain, '.'); idx >= 0 {
+		intPart, fracPart = plain[:idx], plain[idx+1:]
+	}
+	if !isDigits(intPart) || "" == intPart || !isDigits(fracPart) {
+		return nil, fmt.Errorf("InvalidNumber: `%s`", value)
+	}
+	if 0 != e {
+		// 1.2c3 has the operands of 1200, and e = 3
+		plain, _ = plainSample(value)
+		intPart, fracPart = plain, ""
+		if idx := strings.IndexByte(plain, '.'); idx >= 0 {
+			intPart, fracPart = plain[:idx], plain[idx+1:]
+		}
+	}
+
+	trimmed := strings.TrimRight(fracPart, "0")
+	o := &operands{
+		i: bigInt(intPart),
+		f: bigInt(fracPart),
+		t: bigInt(trimmed),
+		v: len(fracPart),
+		w: len(trimmed),
+		e: e,
+	}
+	o.n, _ = new(big.Rat).SetString(intPart + "." + fracPart + "0")
+	o.fraction = new(big.Rat).Sub(o.n, new(big.Rat).SetInt(o.i))
+	return o, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func bigInt(digits string) *big.Int {
+	i, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return i
+}
+
+// value returns the operand, modulo mod unless nil. "n % 10" keeps the
+// fraction of n, 21.5 % 10 being 1.5.
+func (o *operands) value(operand byte, mod *big.Int) *big.Rat {
+	var x *big.Int
+	switch operand {
+	case 'n':
+		if nil == mod {
+			return o.n
+		}
+		x := new(big.Int).Mod(o.i, mod)
+		return new(big.Rat).Add(new(big.Rat).SetInt(x), o.fraction)
+	case 'i':
+		x = o.i
+	case 'f':
+		x = o.f
+	case 't':
+		x = o.t
+	case 'v':
+		x = big.NewInt(int64(o.v))
+	case 'w':
+		x = big.NewInt(int64(o.w))
+	default:
+		// "c" and "e", the exponent of the compact notation
+		x = big.NewInt(int64(o.e))
+	}
+	if nil != mod {
+		x = new(big.Int).Mod(x, mod)
+	}
+	return new(big.Rat).SetInt(x)
+}
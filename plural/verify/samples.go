@@ -0,0 +1,146 @@
+package verify
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+)
+
+// MaxRange bounds the number of values a sample range expands to.
+const MaxRange = 1000
+
+// ExpandSamples returns the values listed by a CLDR sample such as
+// "0~15, 100, 1000, …" or "0.0~1.5, 1.1c6", ranges being expanded by steps
+// of their last digit. Compact values keep their exponent, written with c
+// so that plural.ParseOperands reads it as the e operand. Ellipses and
+// malformed values are skipped.
+func ExpandSamples(samples string) []string {
+	var result []string
+	for _, item := range strings.Split(samples, ",") {
+		item = strings.TrimSpace(item)
+		if "" == item || "…" == item || "..." == item {
+			continue
+		}
+
+		bounds := strings.SplitN(item, "~", 2)
+		if 1 == len(bounds) {
+			if _, ok := plainSample(item); ok {
+				result = append(result, strings.Replace(item, "e", "c", 1))
+			}
+			continue
+		}
+		from, ok := plainSample(bounds[0])
+		if !ok {
+			continue
+		}
+		to, ok := plainSample(bounds[1])
+		if !ok {
+			continue
+		}
+		result = append(result, expandRange(from, to)...)
+	}
+	return result
+}
+
+// plainSample writes out the compact exponent of a sample, "1.1c6" becoming
+// "1100000".
+func plainSample(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	exp := 0
+	if idx := strings.IndexAny(s, "ce"); idx >= 0 {
+		if idx+1 == len(s) {
+			return "", false
+		}
+		for _, c := range s[idx+1:] {
+			if c < '0' || c > '9' || exp > MaxRange {
+				return "", false
+			}
+			exp = exp*10 + int(c-'0')
+		}
+		s = s[:idx]
+	}
+
+	intPart, fracPart := s, ""
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		intPart, fracPart = s[:idx], s[idx+1:]
+	}
+	if "" == intPart || !isDigits(intPart) || !isDigits(fracPart) {
+		return "", false
+	}
+	if 0 == exp {
+		return s, true
+	}
+
+	if exp >= len(fracPart) {
+		intPart += fracPart + strings.Repeat("0", exp-len(fracPart))
+		fracPart = ""
+	} else {
+		intPart, fracPart = intPart+fracPart[:exp], fracPart[exp:]
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	if "" == intPart {
+		intPart = "0"
+	}
+	if "" == fracPart {
+		return intPart, true
+	}
+	return intPart + "." + fracPart, true
+}
+
+func scale(s string) int {
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		return len(s) - idx - 1
+	}
+	return 0
+}
+
+func expandRange(from, to string) []string {
+	digits := scale(from)
+	if scale(to) > digits {
+		digits = scale(to)
+	}
+
+	x, _ := new(big.Rat).SetString(from)
+	end, _ := new(big.Rat).SetString(to)
+	step := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil))
+
+	var result []string
+	for n := 0; x.Cmp(end) <= 0 && n < MaxRange; n++ {
+		result = append(result, x.FloatString(digits))
+		x.Add(x, step)
+	}
+	return result
+}
+
+// Sample is a value whose category CLDR gives.
+type Sample struct {
+	Value    string
+	Ordinal  bool
+	Expected string
+}
+
+// RandomValue returns a non-negative decimal string, mostly small numbers
+// with up to 3 fraction digits, sometimes more than 18 integer digits.
+func RandomValue(r *rand.Rand) string {
+	if 0 == r.Intn(8) {
+		return "0"
+	}
+
+	var b strings.Builder
+	digits := 1 + r.Intn(4)
+	if 0 == r.Intn(8) {
+		digits = 1 + r.Intn(24)
+	}
+	b.WriteByte(byte('1' + r.Intn(9)))
+	for i := 1; i < digits; i++ {
+		b.WriteByte(byte('0' + r.Intn(10)))
+	}
+
+	if fraction := r.Intn(6) - 2; fraction > 0 {
+		b.WriteByte('.')
+		for i := 0; i < fraction; i++ {
+			b.WriteByte(byte('0' + r.Intn(10)))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package verify
+
+import (
+	"fmt"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// Func is a plural function under verification, taking decimal strings.
+type Func func(value string, ordinal bool) string
+
+// Mismatch is a value whose category differs between the function under
+// verification and the interpreter, or the CLDR samples.
+type Mismatch struct {
+	Lang     string
+	Value    string
+	Operands plural.Operands
+	Ordinal  bool
+	Expected string
+	Got      string
+}
+
+func newMismatch(lang, value string, ordinal bool, expected, got string) Mismatch {
+	o, _ := plural.ParseOperands(value)
+	return Mismatch{lang, value, o, ordinal, expected, got}
+}
+
+func (m Mismatch) String() string {
+	kind := "cardinal"
+	if m.Ordinal {
+		kind = "ordinal"
+	}
+	o := m.Operands
+	return fmt.Sprintf("`%s` %s %s (i=%d v=%d w=%d f=%d t=%d): expecting <%s> but got <%s>",
+		m.Lang, kind, m.Value, o.I, o.V, o.W, o.F, o.T, m.Expected, m.Got)
+}
+
+// Verifier checks a plural function of lang against its CLDR rules.
+type Verifier struct {
+	Lang  string
+	Rules *Rules
+	Fn    Func
+}
+
+// New returns a verifier of fn against rules.
+func New(lang string, rules *Rules, fn Func) *Verifier {
+	return &Verifier{lang, rules, fn}
+}
+
+// Check evaluates value with fn and the interpreter, both as a cardinal and
+// an ordinal, and returns their mismatches.
+func (v *Verifier) Check(value string) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for _, ordinal := range []bool{false, true} {
+		expected, err := v.Rules.Eval(value, ordinal)
+		if nil != err {
+			return nil, fmt.Errorf("`%s`: %s", v.Lang, err)
+		}
+		if got := v.Fn(value, ordinal); got != expected {
+			mismatches = append(mismatches, newMismatch(v.Lang, value, ordinal, expected, got))
+		}
+	}
+	return mismatches, nil
+}
+
+// CheckAll checks every value.
+func (v *Verifier) CheckAll(values []string) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for _, value := range values {
+		m, err := v.Check(value)
+		if nil != err {
+			return nil, err
+		}
+		mismatches = append(mismatches, m...)
+	}
+	return mismatches, nil
+}
+
+// CheckSamples checks that the interpreter and fn both give the categories of
+// the CLDR samples. Mismatches of the interpreter have " (interpreter)"
+// appended to their lang, telling apart rules the generator got wrong.
+func (v *Verifier) CheckSamples(samples []Sample) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for _, s := range samples {
+		result, err := v.Rules.Eval(s.Value, s.Ordinal)
+		if nil != err {
+			return nil, fmt.Errorf("`%s`: %s", v.Lang, err)
+		}
+		if result != s.Expected {
+			mismatches = append(mismatches, newMismatch(v.Lang+" (interpreter)", s.Value, s.Ordinal, s.Expected, result))
+		}
+		if got := v.Fn(s.Value, s.Ordinal); got != s.Expected {
+			mismatches = append(mismatches, newMismatch(v.Lang, s.Value, s.Ordinal, s.Expected, got))
+		}
+	}
+	return mismatches, nil
+}
+
+// Diff evaluates fn and other on every value, both as a cardinal and an
+// ordinal, and returns where they differ, other giving the expected category.
+func Diff(lang string, fn, other Func, values []string) []Mismatch {
+	var mismatches []Mismatch
+	for _, value := range values {
+		for _, ordinal := range []bool{false, true} {
+			expected, got := other(value, ordinal), fn(value, ordinal)
+			if got != expected {
+				mismatches = append(mismatches, newMismatch(lang, value, ordinal, expected, got))
+			}
+		}
+	}
+	return mismatches
+}
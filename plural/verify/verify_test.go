@@ -0,0 +1,277 @@
+package verify
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+func TestExpandSamples(t *testing.T) {
+	tests := []struct {
+		samples  string
+		expected []string
+	}{
+		{"0~3, 100, 1000, …", []string{"0", "1", "2", "3", "100", "1000"}},
+		{"0.0~0.3, 10.0, …", []string{"0.0", "0.1", "0.2", "0.3", "10.0"}},
+		{"1.00~1.02", []string{"1.00", "1.01", "1.02"}},
+		{"1c6, 1.1c6, 1.5e3, 2.1c2", []string{"1c6", "1.1c6", "1.5c3", "2.1c2"}},
+		{"abc, 2, 1c, 1.1c-3", []string{"2"}},
+	}
+	for _, test := range tests {
+		if result := ExpandSamples(test.samples); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("`%s` expecting %q but got %q", test.samples, test.expected, result)
+		}
+	}
+}
+
+// loadCLDR reads the "plurals-type-"+key rules of a cldr-json file of
+// testdata, the CLDR release the plural package is generated from. The "two"
+// rule of kw is the one make-plural patches it with.
+func loadCLDR(t testing.TB, file, key string) map[string]map[string]string {
+	contents, err := ioutil.ReadFile(filepath.Join("testdata", file))
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	var document struct {
+		Supplemental map[string]json.RawMessage `json:"supplemental"`
+	}
+	var rules map[string]map[string]string
+	if err = json.Unmarshal(contents, &document); nil == err {
+		err = json.Unmarshal(document.Supplemental["plurals-type-"+key], &rules)
+	}
+	if nil != err {
+		t.Fatalf("%s: %s", file, err.Error())
+	}
+	return rules
+}
+
+func TestRulesEval(t *testing.T) {
+	rules, err := Compile(loadCLDR(t, "plurals.json", "cardinal")["ru"], nil)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	tests := map[string]string{
+		"1":                    "one",
+		"21":                   "one",
+		"11":                   "many",
+		"3":                    "few",
+		"1.5":                  "other",
+		"12345678901234567891": "one",
+	}
+	for value, expected := range tests {
+		if result, err := rules.Eval(value, false); nil != err || result != expected {
+			t.Errorf("`%s` expecting <%s> but got <%s> (%v)", value, expected, result, err)
+		}
+	}
+	if result, _ := rules.Eval("1", true); "other" != result {
+		t.Errorf("`1` ordinal expecting <other> but got <%s>", result)
+	}
+
+	if _, err := rules.Eval("-1", false); nil == err {
+		t.Errorf("`-1` expecting an error")
+	}
+}
+
+func TestRulesEvalExponent(t *testing.T) {
+	// the "many" rule of French since CLDR 38
+	rules, err := Compile(map[string]string{
+		"one":   "i = 0,1 @integer 0, 1 @decimal 0.0~1.5",
+		"many":  "e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5 @integer 1000000, 1c6, 2c6, 3c6, 4c6, 5c6, 6c6, …",
+		"other": " @integer 2~17, 100, 1000, 10000, 100000, 1c3, 2c3, …",
+	}, nil)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	tests := map[string]string{
+		"1000000": "many",
+		"1c6":     "many",
+		"1.5c6":   "many",
+		"1c3":     "other",
+		"2000":    "other",
+		"1.5":     "one",
+	}
+	for value, expected := range tests {
+		if result, err := rules.Eval(value, false); nil != err || result != expected {
+			t.Errorf("`%s` expecting <%s> but got <%s> (%v)", value, expected, result, err)
+		}
+	}
+}
+
+func TestCompileInvalid(t *testing.T) {
+	for _, rule := range []string{"n = ", "x = 1", "n % 0 = 1", "n = 3..1", "n is 1", "n = 1 and"} {
+		if _, err := Compile(map[string]string{"one": rule}, nil); nil == err {
+			t.Errorf("`%s` expecting an error", rule)
+		}
+	}
+}
+
+type verifiedLang struct {
+	lang  string
+	rules *Rules
+	// first is set for the first locale of identical rules
+	first bool
+}
+
+// verifiedLangs returns the locales of the CLDR rules of testdata.
+func verifiedLangs(t testing.TB) []verifiedLang {
+	plurals := loadCLDR(t, "plurals.json", "cardinal")
+	ordinals := loadCLDR(t, "ordinals.json", "ordinal")
+
+	langs := make([]string, 0, len(plurals))
+	for lang := range plurals {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	seen := make(map[string]bool)
+	verified := make([]verifiedLang, 0, len(langs))
+	for _, lang := range langs {
+		rules, err := Compile(plurals[lang], ordinals[lang])
+		if nil != err {
+			t.Fatalf("`%s` %s", lang, err.Error())
+		}
+		key := fmt.Sprint(plurals[lang], ordinals[lang])
+		verified = append(verified, verifiedLang{lang, rules, !seen[key]})
+		seen[key] = true
+	}
+	return verified
+}
+
+func newVerifier(t testing.TB, l verifiedLang) *Verifier {
+	fn, err := plural.GetFunc(language.MustParse(l.lang))
+	if nil != err {
+		t.Fatalf("`%s` unexpected error: %s", l.lang, err.Error())
+	}
+
+	return New(l.lang, l.rules, func(value string, ordinal bool) string {
+		return fn(value, ordinal)
+	})
+}
+
+func reportMismatches(t testing.TB, mismatches []Mismatch, err error) {
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	for i, m := range mismatches {
+		if i == 10 {
+			t.Errorf("... and %d more", len(mismatches)-i)
+			break
+		}
+		t.Error(m)
+	}
+}
+
+func verifiedValues(r *rand.Rand) []string {
+	var values []string
+	for i := 0; i <= 1200; i++ {
+		values = append(values, strconv.Itoa(i))
+	}
+	for i := 0; i <= 120; i++ {
+		for _, fraction := range []string{".0", ".00", ".1", ".10", ".5", ".01", ".25", ".001"} {
+			values = append(values, strconv.Itoa(i)+fraction)
+		}
+	}
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		seen[value] = true
+	}
+	for i := 0; i < 500; i++ {
+		if value := RandomValue(r); !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func TestGeneratedFuncs(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := verifiedValues(r)
+
+	for _, l := range verifiedLangs(t) {
+		v := newVerifier(t, l)
+		m, err := v.CheckSamples(l.rules.Samples())
+		reportMismatches(t, m, err)
+		if l.first {
+			m, err := v.CheckAll(values)
+			reportMismatches(t, m, err)
+		}
+	}
+}
+
+func FuzzGeneratedFuncs(f *testing.F) {
+	for _, seed := range []string{"0", "1", "21", "1.5", "0.10", "12345678901234567891", "100000.25"} {
+		f.Add(seed)
+	}
+
+	var verifiers []*Verifier
+	for _, l := range verifiedLangs(f) {
+		if l.first {
+			verifiers = append(verifiers, newVerifier(f, l))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		if _, err := newOperands(value); nil != err {
+			t.Skip()
+		}
+		for _, v := range verifiers {
+			m, err := v.Check(value)
+			reportMismatches(t, m, err)
+		}
+	})
+}
+
+var xtext = flag.Bool("xtext", false, "report where golang.org/x/text/feature/plural disagrees")
+
+// TestXTextDiff reports the categories golang.org/x/text/feature/plural gives
+// differently for every locale of Info.Langs(). The rules of both packages
+// come from different CLDR releases, so mismatches are only logged:
+//
+//	go test ./verify -run XTextDiff -xtext -v
+func TestXTextDiff(t *testing.T) {
+	if !*xtext {
+		t.Skip("use -xtext to compare with golang.org/x/text/feature/plural")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	values := verifiedValues(r)
+
+	t.Logf("golang.org/x/text/feature/plural uses CLDR %s", XTextCLDRVersion)
+	for _, lang := range plural.Info.Langs() {
+		tag := language.MustParse(lang)
+		fn, err := plural.GetFunc(tag)
+		if nil != err {
+			t.Fatalf("`%s` unexpected error: %s", lang, err.Error())
+		}
+
+		mismatches := Diff(lang, func(value string, ordinal bool) string {
+			return fn(value, ordinal)
+		}, XTextFunc(tag), values)
+		if 0 == len(mismatches) {
+			continue
+		}
+
+		t.Logf("`%s`: %d mismatches", lang, len(mismatches))
+		for i, m := range mismatches {
+			if i == 5 {
+				t.Logf("... and %d more", len(mismatches)-i)
+				break
+			}
+			t.Log(m)
+		}
+	}
+}
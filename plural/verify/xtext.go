@@ -0,0 +1,46 @@
+package verify
+
+import (
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// XTextCLDRVersion is the CLDR version of the rules of XTextFunc.
+const XTextCLDRVersion = plural.CLDRVersion
+
+var xtextForms = [...]string{
+	plural.Other: "other",
+	plural.Zero:  "zero",
+	plural.One:   "one",
+	plural.Two:   "two",
+	plural.Few:   "few",
+	plural.Many:  "many",
+}
+
+// XTextFunc returns the plural function of golang.org/x/text/feature/plural
+// for lang, taking non-negative decimal strings. Values it cannot read are
+// "other".
+func XTextFunc(lang language.Tag) Func {
+	return func(value string, ordinal bool) string {
+		intPart, fracPart := value, ""
+		if idx := strings.IndexByte(value, '.'); idx >= 0 {
+			intPart, fracPart = value[:idx], value[idx+1:]
+		}
+		if !isDigits(intPart) || !isDigits(fracPart) {
+			return "other"
+		}
+
+		digits := []byte(intPart + fracPart)
+		for i := range digits {
+			digits[i] -= '0'
+		}
+
+		rules := plural.Cardinal
+		if ordinal {
+			rules = plural.Ordinal
+		}
+		return xtextForms[rules.MatchDigits(lang, digits, len(intPart), len(fracPart))]
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/text/language"
+
+	"github.com/louischan-oursky/gomakeplural/plural"
+)
+
+// Status of a CLDR locale in the report.
+const (
+	statusRules   = "rules"
+	statusOthers  = "others"
+	statusSkipped = "skipped"
+)
+
+// reportRow is the coverage of one CLDR locale.
+type reportRow struct {
+	Locale string `json:"locale"`
+	// Tag is the canonical form of Locale, under which the plural function
+	// is generated.
+	Tag    string `json:"tag"`
+	Status string `json:"status"`
+	// Reason tells why a skipped locale was left out.
+	Reason string `json:"reason,omitempty"`
+
+	Cardinal []string `json:"cardinal,omitempty"`
+	// Ordinal is only "other" when CLDR has no ordinal data, HasOrdinal
+	// being false.
+	Ordinal    []string `json:"ordinal,omitempty"`
+	HasOrdinal bool     `json:"hasOrdinal"`
+	Operands   []string `json:"operands,omitempty"`
+	// Aliases are the other locales sharing the rules of Locale.
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+func newReport(cultures []string, parsed *parsedCultures, allOrdinals map[string]map[string]string) []reportRow {
+	groups := make(map[string]*plural.Culture)
+	for _, data := range parsed.datas {
+		for _, lang := range data.Langs {
+			groups[lang] = data
+		}
+	}
+	others := make(map[string]bool, len(parsed.others))
+	for _, lang := range parsed.others {
+		others[lang] = true
+	}
+
+	rows := make([]reportRow, 0, len(cultures))
+	for _, culture := range cultures {
+		t := language.MustParse(culture)
+		_, hasOrdinal := allOrdinals[culture]
+		row := reportRow{
+			Locale:     culture,
+			Tag:        t.String(),
+			HasOrdinal: hasOrdinal,
+		}
+
+		if issue := parsed.skipped(culture); nil != issue {
+			row.Status, row.Reason = statusSkipped, fmt.Sprintf("%s: %s", issue.Severity, issue.Err)
+			rows = append(rows, row)
+			continue
+		}
+
+		if data, ok := groups[culture]; ok {
+			row.Status = statusRules
+			row.Cardinal = categories(data.Cardinal)
+			row.Ordinal = categories(data.Ordinal)
+			row.Operands = operands(data)
+			for _, lang := range data.Langs {
+				if lang != culture {
+					row.Aliases = append(row.Aliases, lang)
+				}
+			}
+		} else if others[culture] {
+			row.Status = statusOthers
+			row.Cardinal = []string{plural.Other.String()}
+			row.Ordinal = []string{plural.Other.String()}
+			if row.Tag != culture {
+				row.Aliases = []string{row.Tag}
+			}
+		} else {
+			// "und", dropped from the Others
+			row.Status, row.Reason = statusSkipped, "Undetermined language"
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// categories returns the forms of cases, "other" last.
+func categories(cases plural.Cases) []string {
+	forms := make([]string, 0, len(cases)+1)
+	for _, c := range cases {
+		if plural.Other.String() != c.Form {
+			forms = append(forms, c.Form)
+		}
+	}
+	return append(forms, plural.Other.String())
+}
+
+// operands returns the TR35 operands the rules of c read, p standing for w.
+func operands(c *plural.Culture) []string {
+	var result []string
+	for _, s := range []struct {
+		used   bool
+		symbol plural.Symbol
+	}{
+		{c.N.Use(), plural.N},
+		{c.I.Use(), plural.I},
+		{c.V.Use(), plural.V},
+		{c.W.Use() || c.P.Use(), plural.W},
+		{c.F.Use(), plural.F},
+		{c.T.Use(), plural.T},
+		{c.E.Use(), plural.E},
+	} {
+		if s.used {
+			result = append(result, s.symbol.Name())
+		}
+	}
+	return result
+}
+
+func writeReport(w io.Writer, format string, rows []reportRow) error {
+	join := func(s []string) string {
+		if 0 == len(s) {
+			return "-"
+		}
+		return strings.Join(s, ",")
+	}
+	yesNo := func(b bool) string {
+		if b {
+			return "yes"
+		}
+		return "no"
+	}
+
+	switch format {
+	case "json":
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+		return e.Encode(rows)
+
+	case "md":
+		fmt.Fprintln(w, "| Locale | Tag | Status | Cardinal | Ordinal | CLDR ordinals | Operands | Aliases | Reason |")
+		fmt.Fprintln(w, "|---|---|---|---|---|---|---|---|---|")
+		for _, r := range rows {
+			fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s | %s | %s | %s |\n",
+				r.Locale, r.Tag, r.Status, join(r.Cardinal), join(r.Ordinal), yesNo(r.HasOrdinal),
+				join(r.Operands), join(r.Aliases), strings.Replace(r.Reason, "|", `\|`, -1))
+		}
+		return nil
+
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "LOCALE\tTAG\tSTATUS\tCARDINAL\tORDINAL\tCLDR ORDINALS\tOPERANDS\tALIASES\tREASON")
+		for _, r := range rows {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				r.Locale, r.Tag, r.Status, join(r.Cardinal), join(r.Ordinal), yesNo(r.HasOrdinal),
+				join(r.Operands), join(r.Aliases), r.Reason)
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("UnknownFormat: `%s`", format)
+}
+
+// parseReportFlags parses the arguments following the report subcommand and
+// returns the output format.
+func parseReportFlags(args []string) (string, error) {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	format := fs.String("format", "text", "Output format: text, md or json")
+	fs.StringVar(user_culture, "culture", *user_culture, "Culture subset")
+	if err := fs.Parse(args); nil != err {
+		return "", err
+	}
+	switch *format {
+	case "text", "md", "json":
+		return *format, nil
+	}
+	return "", fmt.Errorf("UnknownFormat: `%s`", *format)
+}
+
+// runReport prints the coverage of the CLDR locales selected by -culture.
+func runReport(w io.Writer, format string, allPlurals, allOrdinals map[string]map[string]string) error {
+	cultures, err := selectCultures(allPlurals)
+	if nil != err {
+		return err
+	}
+	parsed := parseCultures(cultures, allPlurals, allOrdinals)
+	return writeReport(w, format, newReport(cultures, parsed, allOrdinals))
+}
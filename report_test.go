@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testReportRules are CLDR rules of a few locales: de and nl share their
+// rules, en alone has ordinal rules, in is the legacy code of id and bad
+// lacks "other".
+func testReportRules() (map[string]map[string]string, map[string]map[string]string) {
+	one := map[string]string{"pluralRule-count-one": "i = 1 and v = 0 @integer 1", "pluralRule-count-other": " @integer 0, 2~16"}
+	other := map[string]string{"pluralRule-count-other": " @integer 0~15"}
+	plurals := map[string]map[string]string{
+		"bad": {"pluralRule-count-one": "n = 1 @integer 1"},
+		"de":  one,
+		"en":  one,
+		"in":  other,
+		"ja":  other,
+		"nl":  one,
+		"xh":  {"pluralRule-count-one": "n = 1 @integer 1", "pluralRule-count-other": " @integer 0, 2~16"},
+	}
+	ordinals := map[string]map[string]string{
+		"en": {"pluralRule-count-one": "n % 10 = 1 and n % 100 != 11 @integer 1, 21", "pluralRule-count-other": " @integer 0, 4~18"},
+		"ja": {"pluralRule-count-other": " @integer 0~15"},
+	}
+	return plurals, ordinals
+}
+
+func testReport(t *testing.T) []reportRow {
+	plurals, ordinals := testReportRules()
+	cultures, err := selectCultures(plurals)
+	if nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	return newReport(cultures, parseCultures(cultures, plurals, ordinals), ordinals)
+}
+
+func TestNewReport(t *testing.T) {
+	rows := testReport(t)
+
+	oneOther, other := []string{"one", "other"}, []string{"other"}
+	expected := []reportRow{
+		{Locale: "bad", Tag: "bad", Status: statusSkipped, Reason: "warning: MissingOther: cardinal rules"},
+		// without CLDR ordinal data, ordinals are only "other"
+		{Locale: "de", Tag: "de", Status: statusRules, Cardinal: oneOther, Ordinal: other, Operands: []string{"i", "v"}, Aliases: []string{"nl"}},
+		{Locale: "en", Tag: "en", Status: statusRules, Cardinal: oneOther, Ordinal: oneOther, HasOrdinal: true, Operands: []string{"n", "i", "v"}},
+		{Locale: "in", Tag: "id", Status: statusOthers, Cardinal: other, Ordinal: other, Aliases: []string{"id"}},
+		{Locale: "ja", Tag: "ja", Status: statusOthers, Cardinal: other, Ordinal: other, HasOrdinal: true},
+		{Locale: "nl", Tag: "nl", Status: statusRules, Cardinal: oneOther, Ordinal: other, Operands: []string{"i", "v"}, Aliases: []string{"de"}},
+		{Locale: "xh", Tag: "xh", Status: statusRules, Cardinal: oneOther, Ordinal: other, Operands: []string{"n"}},
+	}
+	if len(expected) != len(rows) {
+		t.Fatalf("Expecting %d rows but got %+v", len(expected), rows)
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(expected[i], rows[i]) {
+			t.Errorf("`%s` expecting %+v but got %+v", expected[i].Locale, expected[i], rows[i])
+		}
+	}
+
+	counts := make(map[string]int)
+	missingOrdinals := 0
+	for _, row := range rows {
+		counts[row.Status]++
+		if statusSkipped != row.Status && !row.HasOrdinal {
+			missingOrdinals++
+		}
+	}
+	if expected := map[string]int{statusRules: 4, statusOthers: 2, statusSkipped: 1}; !reflect.DeepEqual(expected, counts) {
+		t.Errorf("Expecting %v but got %v", expected, counts)
+	}
+	if 4 != missingOrdinals {
+		t.Errorf("Expecting 4 locales without CLDR ordinals but got %d", missingOrdinals)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	rows := testReport(t)
+
+	var b bytes.Buffer
+	if err := writeReport(&b, "json", rows); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	var decoded []reportRow
+	if err := json.Unmarshal(b.Bytes(), &decoded); nil != err || !reflect.DeepEqual(rows, decoded) {
+		t.Errorf("Unexpected JSON %s (%v)", b.String(), err)
+	}
+
+	b.Reset()
+	if err := writeReport(&b, "md", rows); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if line := "| en | en | rules | one,other | one,other | yes | n,i,v | - |  |\n"; !strings.Contains(b.String(), line) {
+		t.Errorf("Expecting %q in %q", line, b.String())
+	}
+
+	b.Reset()
+	if err := writeReport(&b, "text", rows); nil != err {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if 1+len(rows) != strings.Count(b.String(), "\n") {
+		t.Errorf("Expecting a line per locale in %q", b.String())
+	}
+
+	if err := writeReport(&b, "csv", rows); nil == err {
+		t.Errorf("`csv` expecting an error")
+	}
+}